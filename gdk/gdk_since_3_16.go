@@ -25,7 +25,6 @@ package gdk
 // #include "gdk_since_3_16.go.h"
 import "C"
 import (
-	"errors"
 	"unsafe"
 
 	"github.com/gotk3/gotk3/glib"
@@ -169,8 +168,7 @@ func (v *GLContext) Realize() (bool, error) {
 	var err *C.GError
 	r := gobool(C.gdk_gl_context_realize(v.native(), &err))
 	if !r {
-		defer C.g_error_free(err)
-		return r, errors.New(C.GoString((*C.char)(err.message)))
+		return r, glib.TakeError(unsafe.Pointer(err))
 	}
 
 	return r, nil
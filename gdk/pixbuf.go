@@ -8,7 +8,6 @@ package gdk
 // #include "pixbuf.go.h"
 import "C"
 import (
-	"errors"
 	"reflect"
 	"runtime"
 	"strconv"
@@ -34,8 +33,38 @@ func init() {
  * Constants
  */
 
-// TODO:
-// GdkPixbufError
+// PixbufErrorQuark is a wrapper around gdk_pixbuf_error_quark().
+func PixbufErrorQuark() glib.Quark {
+	return glib.Quark(C.gdk_pixbuf_error_quark())
+}
+
+// PixbufError is a representation of GDK's GdkPixbufError, the codes of
+// the errors of the PixbufErrorQuark() domain.
+type PixbufError int
+
+const (
+	PIXBUF_ERROR_CORRUPT_IMAGE         PixbufError = C.GDK_PIXBUF_ERROR_CORRUPT_IMAGE
+	PIXBUF_ERROR_INSUFFICIENT_MEMORY   PixbufError = C.GDK_PIXBUF_ERROR_INSUFFICIENT_MEMORY
+	PIXBUF_ERROR_BAD_OPTION            PixbufError = C.GDK_PIXBUF_ERROR_BAD_OPTION
+	PIXBUF_ERROR_UNKNOWN_TYPE          PixbufError = C.GDK_PIXBUF_ERROR_UNKNOWN_TYPE
+	PIXBUF_ERROR_UNSUPPORTED_OPERATION PixbufError = C.GDK_PIXBUF_ERROR_UNSUPPORTED_OPERATION
+	PIXBUF_ERROR_FAILED                PixbufError = C.GDK_PIXBUF_ERROR_FAILED
+)
+
+// Sentinel errors of the PixbufErrorQuark() domain, to be used with
+// errors.Is.
+var (
+	PixbufErrorCorruptImage         = pixbufError(PIXBUF_ERROR_CORRUPT_IMAGE, "corrupt image")
+	PixbufErrorInsufficientMemory   = pixbufError(PIXBUF_ERROR_INSUFFICIENT_MEMORY, "insufficient memory")
+	PixbufErrorBadOption            = pixbufError(PIXBUF_ERROR_BAD_OPTION, "bad option")
+	PixbufErrorUnknownType          = pixbufError(PIXBUF_ERROR_UNKNOWN_TYPE, "unknown image type")
+	PixbufErrorUnsupportedOperation = pixbufError(PIXBUF_ERROR_UNSUPPORTED_OPERATION, "unsupported operation")
+	PixbufErrorFailed               = pixbufError(PIXBUF_ERROR_FAILED, "operation failed")
+)
+
+func pixbufError(code PixbufError, message string) *glib.Error {
+	return glib.ErrorNew(PixbufErrorQuark(), int(code), message)
+}
 
 // PixbufRotation is a representation of GDK's GdkPixbufRotation.
 type PixbufRotation int
@@ -127,8 +156,7 @@ func PixbufNewFromFile(filename string) (*Pixbuf, error) {
 	var err *C.GError
 	c := C.gdk_pixbuf_new_from_file((*C.char)(cstr), &err)
 	if c == nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(C.GoString((*C.char)(err.message)))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 
 	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
@@ -187,8 +215,7 @@ func PixbufNewFromResource(resourcePath string) (*Pixbuf, error) {
 	c := C.gdk_pixbuf_new_from_resource((*C.gchar)(cstr), &gerr)
 
 	if gerr != nil {
-		defer C.g_error_free(gerr)
-		return nil, errors.New(C.GoString(gerr.message))
+		return nil, glib.TakeError(unsafe.Pointer(gerr))
 	}
 
 	obj := glib.Take(unsafe.Pointer(c))
@@ -213,8 +240,7 @@ func PixbufNewFromResourceAtScale(resourcePath string, width, height int, preser
 	)
 
 	if gerr != nil {
-		defer C.g_error_free(gerr)
-		return nil, errors.New(C.GoString(gerr.message))
+		return nil, glib.TakeError(unsafe.Pointer(gerr))
 	}
 
 	obj := glib.Take(unsafe.Pointer(c))
@@ -410,8 +436,7 @@ func (v *Pixbuf) SaveJPEG(path string, quality int) error {
 	var err *C.GError
 	c := C._gdk_pixbuf_save_jpeg(v.native(), cpath, &err, cquality)
 	if !gobool(c) {
-		defer C.g_error_free(err)
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 
 	return nil
@@ -428,8 +453,7 @@ func (v *Pixbuf) SavePNG(path string, compression int) error {
 	var err *C.GError
 	c := C._gdk_pixbuf_save_png(v.native(), cpath, &err, ccompression)
 	if !gobool(c) {
-		defer C.g_error_free(err)
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
@@ -513,8 +537,7 @@ func PixbufAnimationNewFromFile(filename string) (*PixbufAnimation, error) {
 	var err *C.GError
 	c := C.gdk_pixbuf_animation_new_from_file((*C.char)(cstr), &err)
 	if c == nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(C.GoString((*C.char)(err.message)))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 
 	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
@@ -569,8 +592,7 @@ func PixbufLoaderNewWithType(t string) (*PixbufLoader, error) {
 
 	c := C.gdk_pixbuf_loader_new_with_type((*C.char)(cstr), &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(C.GoString((*C.char)(err.message)))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 
 	if c == nil {
@@ -599,8 +621,7 @@ func (v *PixbufLoader) Write(data []byte) (int, error) {
 		&err)
 
 	if !gobool(c) {
-		defer C.g_error_free(err)
-		return 0, errors.New(C.GoString((*C.char)(err.message)))
+		return 0, glib.TakeError(unsafe.Pointer(err))
 	}
 
 	return len(data), nil
@@ -627,8 +648,7 @@ func (v *PixbufLoader) Close() error {
 	var err *C.GError
 
 	if ok := gobool(C.gdk_pixbuf_loader_close(v.native(), &err)); !ok {
-		defer C.g_error_free(err)
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
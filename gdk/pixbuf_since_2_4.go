@@ -13,7 +13,6 @@ package gdk
 // #include "pixbuf_since_2_4.go.h"
 import "C"
 import (
-	"errors"
 	"io"
 	"reflect"
 	"runtime"
@@ -68,8 +67,7 @@ func (v *Pixbuf) WritePNG(w io.Writer, compression int) error {
 	callback.Delete(id)
 
 	if !gobool(c) {
-		defer C.g_error_free(err)
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 
 	return nil
@@ -90,8 +88,7 @@ func (v *Pixbuf) WriteJPEG(w io.Writer, quality int) error {
 	callback.Delete(id)
 
 	if !gobool(c) {
-		defer C.g_error_free(err)
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 
 	return nil
@@ -114,8 +111,7 @@ func PixbufNewFromFileAtSize(filename string, width, height int) (*Pixbuf, error
 	var err *C.GError = nil
 	c := C.gdk_pixbuf_new_from_file_at_size(cstr, C.int(width), C.int(height), &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(C.GoString((*C.char)(err.message)))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 
 	if c == nil {
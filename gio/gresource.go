@@ -9,6 +9,8 @@ import "C"
 import (
 	"errors"
 	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
 )
 
 // ResourceLookupFlags is a representation of GTK's GResourceLookupFlags
@@ -37,8 +39,7 @@ func LoadGResource(path string) (GResource, error) {
 
 	resPtr := C.g_resource_load((*C.gchar)(unsafe.Pointer(cpath)), &gerr)
 	if gerr != nil {
-		defer C.g_error_free(gerr)
-		return nil, errors.New(goString(gerr.message))
+		return nil, glib.TakeError(unsafe.Pointer(gerr))
 	}
 
 	res := wrapGResource(resPtr)
@@ -53,8 +54,7 @@ func NewGResourceFromData(data []byte) (GResource, error) {
 	var gerr *C.GError
 	resPtr := C.g_resource_new_from_data(arrayPtr, &gerr)
 	if gerr != nil {
-		defer C.g_error_free(gerr)
-		return nil, errors.New(goString(gerr.message))
+		return nil, glib.TakeError(unsafe.Pointer(gerr))
 	}
 
 	res := wrapGResource(resPtr)
@@ -84,8 +84,7 @@ func GResourceEnumerateChildren(path string, flags ResourceLookupFlags) ([]strin
 	var gerr *C.GError
 	arrChildren := C.g_resources_enumerate_children(cpath, flags.native(), &gerr)
 	if gerr != nil {
-		defer C.g_error_free(gerr)
-		return nil, errors.New(goString(gerr.message))
+		return nil, glib.TakeError(unsafe.Pointer(gerr))
 	}
 
 	if arrChildren == nil {
@@ -4,6 +4,7 @@ package glib
 // #include <glib.h>
 // #include <glib-object.h>
 // #include "glib.go.h"
+// #include "application.go.h"
 import "C"
 import "unsafe"
 
@@ -101,6 +102,16 @@ func (v *Application) GetDbusObjectPath() string {
 	return C.GoString((*C.char)(c))
 }
 
+// GetDBusConnection is a wrapper around g_application_get_dbus_connection().
+// It returns nil if the application is not registered or does not use D-Bus.
+func (v *Application) GetDBusConnection() *DBusConnection {
+	c := C.g_application_get_dbus_connection(v.native())
+	if c == nil {
+		return nil
+	}
+	return wrapDBusConnection(Take(unsafe.Pointer(c)))
+}
+
 // GetIsRegistered is a wrapper around g_application_get_is_registered().
 func (v *Application) GetIsRegistered() bool {
 	return gobool(C.g_application_get_is_registered(v.native()))
@@ -185,12 +196,106 @@ func (v *Application) Run(args []string) int {
 	return int(C.g_application_run(v.native(), C.int(len(args)), cargs))
 }
 
+// Register is a wrapper around g_application_register(). It is called by
+// Run, and is only needed to know whether the application is remote before
+// running it, see GetIsRemote.
+func (v *Application) Register(cancellable *Cancellable) error {
+	var gerr *C.GError
+	if !gobool(C.g_application_register(v.native(), cancellable.native(), &gerr)) {
+		return takeError(gerr)
+	}
+	return nil
+}
+
+// Open is a wrapper around g_application_open(). The application must have
+// the APPLICATION_HANDLES_OPEN flag. hint is an optional hint about the kind
+// of opening, like "edit" or "view", or an empty string.
+func (v *Application) Open(files []*File, hint string) {
+	cfiles := C._g_file_array_new(C.int(len(files)))
+	defer C.g_free(C.gpointer(cfiles))
+
+	for i, f := range files {
+		C._g_file_array_set(cfiles, C.int(i), f.native())
+	}
+
+	chint := (*C.gchar)(C.CString(hint))
+	defer C.free(unsafe.Pointer(chint))
+
+	C.g_application_open(v.native(), cfiles, C.gint(len(files)), chint)
+}
+
+// AddMainOptionEntries is a wrapper around
+// g_application_add_main_option_entries(). The parsed options are found in
+// the VariantDict given to the "handle-local-options" handler, and in the
+// one of the ApplicationCommandLine of the "command-line" handler. The
+// strings of the entries are kept for the lifetime of the program, as GLib
+// does not copy them.
+func (v *Application) AddMainOptionEntries(entries []OptionEntry) {
+	// The array itself is copied, only its strings must stay alive.
+	centries := C._g_option_entries_new(C.int(len(entries)))
+	defer C.g_free(C.gpointer(centries))
+
+	for i, e := range entries {
+		var cdesc, cargDesc *C.gchar
+		if e.Description != "" {
+			cdesc = (*C.gchar)(C.CString(e.Description))
+		}
+		if e.ArgDescription != "" {
+			cargDesc = (*C.gchar)(C.CString(e.ArgDescription))
+		}
+		C._g_option_entry_set(centries, C.int(i), (*C.gchar)(C.CString(e.LongName)), C.gchar(e.ShortName),
+			C.gint(e.Flags), C.GOptionArg(e.Arg), cdesc, cargDesc)
+	}
+
+	C.g_application_add_main_option_entries(v.native(), centries)
+}
+
+// ConnectCommandLine connects f to the "command-line" signal, emitted in
+// the primary instance of an application with the
+// APPLICATION_HANDLES_COMMAND_LINE flag for each invocation, local or
+// remote. The value returned by f is the exit status of the invocation.
+//
+// The ApplicationCommandLine only borrows the reference of the emission, as
+// GIO sends the exit status to a remote invocation when it is finalized.
+// To handle the invocation after f returns, call Ref on it and Unref once
+// done.
+func (v *Application) ConnectCommandLine(f func(*Application, *ApplicationCommandLine) int) SignalHandle {
+	return v.ConnectMarshal("command-line", func(ret *Value, p []Value) {
+		cmdline := newObject((*C.GObject)(C.g_value_get_object(p[1].native())))
+		ret.SetInt(f(wrapApplication(p[0].GetObject()), wrapApplicationCommandLine(cmdline)))
+	})
+}
+
+// ConnectHandleLocalOptions connects f to the "handle-local-options"
+// signal, emitted in the invoking process after parsing the options added
+// with AddMainOptionEntries, which f may modify before they are sent to the
+// primary instance. f returns -1 to continue running the application, or an
+// exit status to exit right away, e.g. 0 after handling --version.
+func (v *Application) ConnectHandleLocalOptions(f func(*Application, *VariantDict) int) SignalHandle {
+	return v.ConnectMarshal("handle-local-options", func(ret *Value, p []Value) {
+		dict := takeVariantDict((*C.GVariantDict)(C.g_value_get_boxed(p[1].native())))
+		ret.SetInt(f(wrapApplication(p[0].GetObject()), dict))
+	})
+}
+
+// ConnectOpen connects f to the "open" signal, emitted in the primary
+// instance of an application with the APPLICATION_HANDLES_OPEN flag when
+// files are given on the command line of an invocation, or with Open.
+func (v *Application) ConnectOpen(f func(app *Application, files []*File, hint string)) SignalHandle {
+	return v.ConnectMarshal("open", func(_ *Value, p []Value) {
+		cfiles := (**C.GFile)(p[1].GetPointer())
+		n := int(C.g_value_get_int(p[2].native()))
+		hint, _ := p[3].GetString()
+
+		files := make([]*File, n)
+		for i := range files {
+			files[i] = wrapFile(Take(unsafe.Pointer(C._g_file_array_get(cfiles, C.int(i)))))
+		}
+		f(wrapApplication(p[0].GetObject()), files, hint)
+	})
+}
+
 // void 	g_application_bind_busy_property ()
 // void 	g_application_unbind_busy_property ()
-// gboolean 	g_application_register () // requires GCancellable
 // void 	g_application_set_action_group () // Deprecated since 2.32
-// GDBusConnection * 	g_application_get_dbus_connection () // No support for GDBusConnection
-// void 	g_application_open () // Needs GFile
-// void 	g_application_add_main_option_entries () //Needs GOptionEntry
-// void 	g_application_add_main_option () //Needs GOptionFlags and GOptionArg
 // void 	g_application_add_option_group () // Needs GOptionGroup
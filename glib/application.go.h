@@ -0,0 +1,48 @@
+// Same copyright and license as the rest of the files in this project
+
+#include <gio/gio.h>
+#include <glib.h>
+
+static GApplicationCommandLine *toGApplicationCommandLine(void *p) {
+  return (G_APPLICATION_COMMAND_LINE(p));
+}
+
+static inline GFile **_g_file_array_new(int n) { return g_new0(GFile *, n); }
+
+static inline GFile *_g_file_array_get(GFile **files, int i) {
+  return files[i];
+}
+
+static inline void _g_file_array_set(GFile **files, int i, GFile *file) {
+  files[i] = file;
+}
+
+static inline void
+_g_application_command_line_print(GApplicationCommandLine *cmdline,
+                                  const gchar *message) {
+  g_application_command_line_print(cmdline, "%s", message);
+}
+
+static inline void
+_g_application_command_line_printerr(GApplicationCommandLine *cmdline,
+                                     const gchar *message) {
+  g_application_command_line_printerr(cmdline, "%s", message);
+}
+
+static inline GOptionEntry *_g_option_entries_new(int n) {
+  return g_new0(GOptionEntry, n + 1);
+}
+
+static inline void _g_option_entry_set(GOptionEntry *entries, int i,
+                                       gchar *long_name, gchar short_name,
+                                       gint flags, GOptionArg arg,
+                                       gchar *description,
+                                       gchar *arg_description) {
+  entries[i].long_name = long_name;
+  entries[i].short_name = short_name;
+  entries[i].flags = flags;
+  entries[i].arg = arg;
+  entries[i].arg_data = NULL;
+  entries[i].description = description;
+  entries[i].arg_description = arg_description;
+}
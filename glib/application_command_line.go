@@ -0,0 +1,158 @@
+package glib
+
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+// #include "application.go.h"
+import "C"
+import "unsafe"
+
+func init() {
+	tm := []TypeMarshaler{
+		{Type(C.g_application_command_line_get_type()), marshalApplicationCommandLine},
+	}
+
+	RegisterGValueMarshalers(tm)
+}
+
+/*
+ * GApplicationCommandLine
+ */
+
+// ApplicationCommandLine is a representation of GIO's
+// GApplicationCommandLine, an invocation of an application, possibly from
+// another process, as received by the "command-line" signal.
+type ApplicationCommandLine struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GApplicationCommandLine.
+func (v *ApplicationCommandLine) native() *C.GApplicationCommandLine {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGApplicationCommandLine(p)
+}
+
+// Native returns a pointer to the underlying GApplicationCommandLine.
+func (v *ApplicationCommandLine) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalApplicationCommandLine(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapApplicationCommandLine(obj), nil
+}
+
+func wrapApplicationCommandLine(obj *Object) *ApplicationCommandLine {
+	if obj == nil {
+		return nil
+	}
+	return &ApplicationCommandLine{obj}
+}
+
+// GetArguments is a wrapper around
+// g_application_command_line_get_arguments(). The first argument is the
+// program name.
+func (v *ApplicationCommandLine) GetArguments() []string {
+	c := C.g_application_command_line_get_arguments(v.native(), nil)
+	if c == nil {
+		return nil
+	}
+	return toGoStringArray(c)
+}
+
+// GetCwd is a wrapper around g_application_command_line_get_cwd(). It
+// returns an empty string if the working directory of the invocation is not
+// known.
+func (v *ApplicationCommandLine) GetCwd() string {
+	return goString(C.g_application_command_line_get_cwd(v.native()))
+}
+
+// GetEnviron is a wrapper around g_application_command_line_get_environ().
+// The environment of remote invocations is only sent with
+// APPLICATION_SEND_ENVIRONMENT.
+func (v *ApplicationCommandLine) GetEnviron() []string {
+	var env []string
+	for p := C.g_application_command_line_get_environ(v.native()); p != nil && *p != nil; p = C.next_gcharptr(p) {
+		env = append(env, goString(*p))
+	}
+	return env
+}
+
+// Getenv is a wrapper around g_application_command_line_getenv().
+func (v *ApplicationCommandLine) Getenv(name string) string {
+	cstr := (*C.gchar)(C.CString(name))
+	defer C.free(unsafe.Pointer(cstr))
+
+	return goString(C.g_application_command_line_getenv(v.native(), cstr))
+}
+
+// GetOptionsDict is a wrapper around
+// g_application_command_line_get_options_dict(). It holds the options added
+// with AddMainOptionEntries, parsed from the arguments of the invocation.
+func (v *ApplicationCommandLine) GetOptionsDict() *VariantDict {
+	return takeVariantDict(C.g_application_command_line_get_options_dict(v.native()))
+}
+
+// GetIsRemote is a wrapper around g_application_command_line_get_is_remote().
+func (v *ApplicationCommandLine) GetIsRemote() bool {
+	return gobool(C.g_application_command_line_get_is_remote(v.native()))
+}
+
+// GetExitStatus is a wrapper around
+// g_application_command_line_get_exit_status().
+func (v *ApplicationCommandLine) GetExitStatus() int {
+	return int(C.g_application_command_line_get_exit_status(v.native()))
+}
+
+// SetExitStatus is a wrapper around
+// g_application_command_line_set_exit_status(). The status is returned by
+// Run in the process of the invocation once the ApplicationCommandLine is
+// released, which allows to report it after the "command-line" handler
+// returned by keeping a reference.
+func (v *ApplicationCommandLine) SetExitStatus(exitStatus int) {
+	C.g_application_command_line_set_exit_status(v.native(), C.int(exitStatus))
+}
+
+// CreateFileForArg is a wrapper around
+// g_application_command_line_create_file_for_arg(). A relative path is
+// resolved against the working directory of the invocation.
+func (v *ApplicationCommandLine) CreateFileForArg(arg string) *File {
+	cstr := (*C.gchar)(C.CString(arg))
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_application_command_line_create_file_for_arg(v.native(), cstr)
+	return wrapFile(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// GetStdin is a wrapper around g_application_command_line_get_stdin(). It
+// returns nil if the standard input of the invocation is not available.
+func (v *ApplicationCommandLine) GetStdin() *InputStream {
+	c := C.g_application_command_line_get_stdin(v.native())
+	if c == nil {
+		return nil
+	}
+	return wrapInputStream(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// Print is a wrapper around g_application_command_line_print(). message is
+// written to the standard output of the invocation.
+func (v *ApplicationCommandLine) Print(message string) {
+	cstr := (*C.gchar)(C.CString(message))
+	defer C.free(unsafe.Pointer(cstr))
+
+	C._g_application_command_line_print(v.native(), cstr)
+}
+
+// PrintErr is a wrapper around g_application_command_line_printerr().
+// message is written to the standard error of the invocation.
+func (v *ApplicationCommandLine) PrintErr(message string) {
+	cstr := (*C.gchar)(C.CString(message))
+	defer C.free(unsafe.Pointer(cstr))
+
+	C._g_application_command_line_printerr(v.native(), cstr)
+}
@@ -0,0 +1,235 @@
+package glib_test
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func TestApplicationCommandLine(t *testing.T) {
+	app := glib.ApplicationNew("org.gotk3.test.CommandLine",
+		glib.APPLICATION_HANDLES_COMMAND_LINE|glib.APPLICATION_NON_UNIQUE)
+	app.AddMainOptionEntries([]glib.OptionEntry{
+		{LongName: "name", ShortName: 'n', Arg: glib.OPTION_ARG_STRING, Description: "Name to greet"},
+		{LongName: "verbose", Arg: glib.OPTION_ARG_NONE},
+	})
+
+	var localVerbose bool
+	app.ConnectHandleLocalOptions(func(_ *glib.Application, options *glib.VariantDict) int {
+		localVerbose = options.Contains("verbose")
+		return -1
+	})
+
+	var args []string
+	var name string
+	app.ConnectCommandLine(func(_ *glib.Application, cmdline *glib.ApplicationCommandLine) int {
+		args = cmdline.GetArguments()
+		if v := cmdline.GetOptionsDict().LookupValue("name", nil); v != nil {
+			name = v.GetString()
+		}
+		if cmdline.GetIsRemote() {
+			t.Error("local invocation reported as remote")
+		}
+		return 3
+	})
+
+	status := app.Run([]string{"prog", "-n", "gopher", "--verbose", "extra"})
+	if status != 3 {
+		t.Errorf("expected exit status 3, got %d", status)
+	}
+	if !localVerbose {
+		t.Error("--verbose not seen by handle-local-options")
+	}
+	if name != "gopher" {
+		t.Errorf("expected name gopher, got %q", name)
+	}
+	if want := []string{"prog", "extra"}; !reflect.DeepEqual(args, want) {
+		t.Errorf("expected arguments %q, got %q", want, args)
+	}
+}
+
+func TestApplicationOpen(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "gotk3-application")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	path := filepath.Join(tmp, "document.txt")
+
+	app := glib.ApplicationNew("org.gotk3.test.Open",
+		glib.APPLICATION_HANDLES_OPEN|glib.APPLICATION_NON_UNIQUE)
+
+	var opened []string
+	var hints []string
+	app.ConnectOpen(func(_ *glib.Application, files []*glib.File, hint string) {
+		for _, f := range files {
+			opened = append(opened, f.GetPath())
+		}
+		hints = append(hints, hint)
+	})
+
+	if status := app.Run([]string{"prog", path}); status != 0 {
+		t.Errorf("expected exit status 0, got %d", status)
+	}
+
+	if err := app.Register(nil); err != nil {
+		t.Fatal("Register failed:", err)
+	}
+	file, err := glib.FileNewForPath(path)
+	if err != nil {
+		t.Fatal("FileNewForPath failed:", err)
+	}
+	app.Open([]*glib.File{file}, "view")
+
+	if want := []string{path, path}; !reflect.DeepEqual(opened, want) {
+		t.Errorf("expected opened files %q, got %q", want, opened)
+	}
+	if want := []string{"", "view"}; !reflect.DeepEqual(hints, want) {
+		t.Errorf("expected hints %q, got %q", want, hints)
+	}
+}
+
+// testApplicationEnv is set to "primary" or "remote" when the test binary is
+// run as an instance of the application of a remote application test.
+const testApplicationEnv = "GOTK3_TEST_APPLICATION"
+
+// runTestApplicationInstance runs app and exits if the test binary was
+// started by startTestApplicationInstance. The primary instance runs until
+// it is released, the remote instance is invoked with args.
+func runTestApplicationInstance(app *glib.Application, args ...string) {
+	switch os.Getenv(testApplicationEnv) {
+	case "primary":
+		app.Connect("startup", func() {
+			app.Hold()
+			fmt.Println("ready")
+		})
+		app.Connect("activate", func() {})
+		os.Exit(app.Run([]string{"prog"}))
+	case "remote":
+		os.Exit(app.Run(append([]string{"prog"}, args...)))
+	}
+}
+
+// startTestApplicationInstance runs the current test in a subprocess as an
+// instance of its application, on the session bus at address, and returns
+// the command with a reader of its output. Each instance needs its own
+// process, as GApplication uses the session bus of the process.
+func startTestApplicationInstance(t *testing.T, address, role string) (*exec.Cmd, *bufio.Reader) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	cmd := exec.CommandContext(ctx, os.Args[0], "-test.run=^"+t.Name()+"$")
+	cmd.Env = append(os.Environ(),
+		"DBUS_SESSION_BUS_ADDRESS="+address,
+		testApplicationEnv+"="+role)
+	cmd.Stderr = os.Stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.Start(); err != nil {
+		t.Fatal("cannot start the", role, "instance:", err)
+	}
+	t.Cleanup(func() { cmd.Process.Kill() })
+	return cmd, bufio.NewReader(stdout)
+}
+
+// readTestApplicationLine reads a line of output of an instance.
+func readTestApplicationLine(t *testing.T, r *bufio.Reader) string {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		t.Fatal("cannot read the output of the instance:", err)
+	}
+	return strings.TrimSuffix(line, "\n")
+}
+
+// startTestApplicationPrimary starts the primary instance of the
+// application of the current test on a private session bus, and returns
+// the command with a reader of its output and the bus address.
+func startTestApplicationPrimary(t *testing.T) (*exec.Cmd, *bufio.Reader, string) {
+	address := startDBusDaemon(t)
+	primary, out := startTestApplicationInstance(t, address, "primary")
+	if line := readTestApplicationLine(t, out); line != "ready" {
+		t.Fatalf("expected the primary instance to be ready, got %q", line)
+	}
+	return primary, out, address
+}
+
+func TestApplicationRemoteCommandLine(t *testing.T) {
+	app := glib.ApplicationNew("org.gotk3.test.RemoteCommandLine", glib.APPLICATION_HANDLES_COMMAND_LINE)
+	app.ConnectCommandLine(func(app *glib.Application, cmdline *glib.ApplicationCommandLine) int {
+		if !cmdline.GetIsRemote() {
+			return 0
+		}
+		fmt.Printf("command-line %q\n", cmdline.GetArguments())
+		cmdline.Print("printed by the primary instance\n")
+		app.Release()
+		return 7
+	})
+	runTestApplicationInstance(app, "one", "two")
+
+	primary, primaryOut, address := startTestApplicationPrimary(t)
+
+	remote, remoteOut := startTestApplicationInstance(t, address, "remote")
+	output, _ := ioutil.ReadAll(remoteOut)
+	err := remote.Wait()
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() != 7 {
+		t.Errorf("expected exit status 7 from the remote instance, got %v", err)
+	}
+	if s := string(output); s != "printed by the primary instance\n" {
+		t.Errorf("unexpected output of the remote instance %q", s)
+	}
+
+	if line := readTestApplicationLine(t, primaryOut); line != `command-line ["prog" "one" "two"]` {
+		t.Errorf("unexpected command line in the primary instance %q", line)
+	}
+	if err := primary.Wait(); err != nil {
+		t.Error("primary instance failed:", err)
+	}
+}
+
+func TestApplicationRemoteOpen(t *testing.T) {
+	app := glib.ApplicationNew("org.gotk3.test.RemoteOpen", glib.APPLICATION_HANDLES_OPEN)
+	app.ConnectOpen(func(app *glib.Application, files []*glib.File, hint string) {
+		var paths []string
+		for _, f := range files {
+			paths = append(paths, f.GetPath())
+		}
+		fmt.Printf("open %q %q\n", paths, hint)
+		app.Release()
+	})
+	runTestApplicationInstance(app, "a.txt", "b.txt")
+
+	primary, primaryOut, address := startTestApplicationPrimary(t)
+
+	remote, remoteOut := startTestApplicationInstance(t, address, "remote")
+	ioutil.ReadAll(remoteOut)
+	if err := remote.Wait(); err != nil {
+		t.Error("remote instance failed:", err)
+	}
+
+	// Files are resolved in the working directory of the remote instance.
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := fmt.Sprintf("open %q %q", []string{filepath.Join(cwd, "a.txt"), filepath.Join(cwd, "b.txt")}, "")
+	if line := readTestApplicationLine(t, primaryOut); line != want {
+		t.Errorf("expected %q in the primary instance, got %q", want, line)
+	}
+	if err := primary.Wait(); err != nil {
+		t.Error("primary instance failed:", err)
+	}
+}
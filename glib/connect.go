@@ -75,6 +75,29 @@ func (v *Object) ConnectAfter(detailedSignal string, f interface{}) SignalHandle
 	return v.connectClosure(true, detailedSignal, f)
 }
 
+// ClosureMarshal is a signal handler receiving the raw parameters of an
+// emission, the instance first. If the signal has a return value, ret is
+// initialized to its type and the handler stores the result in it, otherwise
+// ret is nil.
+//
+// Unlike the functions given to Connect, a ClosureMarshal is called directly
+// instead of through reflection, which makes it suited to signals emitted
+// often. It is the building block of the typed Connect helpers, such as
+// gtk's Button.ConnectClicked.
+type ClosureMarshal func(ret *Value, params []Value)
+
+// ConnectMarshal is a wrapper around g_signal_connect_closure() connecting a
+// ClosureMarshal. For more information, refer to Connect and ClosureMarshal.
+func (v *Object) ConnectMarshal(detailedSignal string, f ClosureMarshal) SignalHandle {
+	return v.connectClosure(false, detailedSignal, f)
+}
+
+// ConnectMarshalAfter is like ConnectMarshal, except that f is invoked after
+// the default handler. For more information, refer to ConnectAfter.
+func (v *Object) ConnectMarshalAfter(detailedSignal string, f ClosureMarshal) SignalHandle {
+	return v.connectClosure(true, detailedSignal, f)
+}
+
 // ClosureCheckReceiver, if true, will make GLib check for every single
 // closure's first argument to ensure that it is correct, otherwise it will
 // panic with a message warning about the possible circular references. The
@@ -87,7 +110,7 @@ const ClosureCheckReceiver = false
 func (v *Object) connectClosure(after bool, detailedSignal string, f interface{}) SignalHandle {
 	fs := closure.NewFuncStack(f, 2)
 
-	if ClosureCheckReceiver {
+	if _, ok := f.(ClosureMarshal); !ok && ClosureCheckReceiver {
 		// This is a bit slow, but we could be careful.
 		objValue, err := v.goValue()
 		if err == nil {
@@ -0,0 +1,104 @@
+//go:build go1.18
+// +build go1.18
+
+package glib
+
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+import "C"
+import "fmt"
+
+// ConnectTyped connects f to a signal of v which has no parameters besides
+// the instance and no return value. The first argument of f has the type of
+// v, so a handler for the wrong receiver type is caught at compile time;
+// that type must be the one gotk3 returns for the instance, e.g. *gtk.Button
+// for a GtkButton. The handler is called without going through reflection,
+// see ClosureMarshal.
+func ConnectTyped[R IObject](v R, detailedSignal string, f func(R)) SignalHandle {
+	return v.toObject().ConnectMarshal(detailedSignal, func(_ *Value, params []Value) {
+		f(paramAs[R](params, 0))
+	})
+}
+
+// ConnectTyped1 is like ConnectTyped, for signals with one parameter.
+func ConnectTyped1[R IObject, A any](v R, detailedSignal string, f func(R, A)) SignalHandle {
+	return v.toObject().ConnectMarshal(detailedSignal, func(_ *Value, params []Value) {
+		f(paramAs[R](params, 0), paramAs[A](params, 1))
+	})
+}
+
+// ConnectTyped2 is like ConnectTyped, for signals with two parameters.
+func ConnectTyped2[R IObject, A, B any](v R, detailedSignal string, f func(R, A, B)) SignalHandle {
+	return v.toObject().ConnectMarshal(detailedSignal, func(_ *Value, params []Value) {
+		f(paramAs[R](params, 0), paramAs[A](params, 1), paramAs[B](params, 2))
+	})
+}
+
+// ConnectTypedReturn is like ConnectTyped, for signals returning a value.
+func ConnectTypedReturn[R IObject, T any](v R, detailedSignal string, f func(R) T) SignalHandle {
+	return v.toObject().ConnectMarshal(detailedSignal, func(ret *Value, params []Value) {
+		setReturn(ret, f(paramAs[R](params, 0)))
+	})
+}
+
+// ConnectTyped1Return is like ConnectTyped1, for signals returning a value.
+func ConnectTyped1Return[R IObject, A, T any](v R, detailedSignal string, f func(R, A) T) SignalHandle {
+	return v.toObject().ConnectMarshal(detailedSignal, func(ret *Value, params []Value) {
+		setReturn(ret, f(paramAs[R](params, 0), paramAs[A](params, 1)))
+	})
+}
+
+// paramAs converts the nth signal parameter to T, the same way goMarshal
+// converts arguments for Connect handlers. It panics if the parameter is
+// missing or of another type; the panic is reported with the place the
+// handler was connected.
+func paramAs[T any](params []Value, n int) T {
+	var zero T
+	if n >= len(params) {
+		panic(fmt.Sprintf("signal has no parameter %d, expected %T", n, zero))
+	}
+
+	val, err := params[n].GoValue()
+	if err != nil {
+		panic(fmt.Sprintf("no suitable Go value for parameter %d: %v", n, err))
+	}
+
+	// Objects are handed out with their most specific Go type, as in
+	// goMarshal.
+	if obj, ok := val.(*Object); ok && obj != nil {
+		if inner, err := obj.goValue(); err == nil {
+			val = inner
+		}
+	}
+
+	if val == nil {
+		return zero
+	}
+
+	t, ok := val.(T)
+	if !ok {
+		panic(fmt.Sprintf("parameter %d is %T, not %T", n, val, zero))
+	}
+	return t
+}
+
+// setReturn stores a handler's return value in ret.
+func setReturn(ret *Value, v interface{}) {
+	if ret == nil {
+		return
+	}
+
+	g, err := GValue(v)
+	if err != nil {
+		panic(fmt.Sprintf("cannot save callback return value: %v", err))
+	}
+
+	if !gobool(C.g_value_type_compatible(C._g_value_type(g.native()), C._g_value_type(ret.native()))) {
+		if !gobool(C.g_value_transform(g.native(), ret.native())) {
+			panic(fmt.Sprintf("cannot convert callback return value %T to %s", v, ret.TypeName()))
+		}
+		return
+	}
+	C.g_value_copy(g.native(), ret.native())
+}
@@ -0,0 +1,112 @@
+//go:build go1.18
+// +build go1.18
+
+package glib_test
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func TestConnectTyped(t *testing.T) {
+	typ, err := glib.RegisterType("GotkTestTypedThing", glib.TYPE_OBJECT, &glib.TypeInfo{
+		InstanceInit: func(obj *glib.Object) *testThing {
+			return &testThing{Object: obj}
+		},
+	})
+	if err != nil {
+		t.Fatal("RegisterType failed:", err)
+	}
+
+	for _, sig := range []struct {
+		name   string
+		ret    glib.Type
+		params []glib.Type
+	}{
+		{"poke", glib.TYPE_NONE, nil},
+		{"add", glib.TYPE_NONE, []glib.Type{glib.TYPE_INT}},
+		{"describe", glib.TYPE_STRING, []glib.Type{glib.TYPE_INT}},
+	} {
+		_, err := glib.SignalNewFull(sig.name, typ, glib.SIGNAL_RUN_LAST, nil, nil, sig.ret, sig.params...)
+		if err != nil {
+			t.Fatal("SignalNewFull failed:", err)
+		}
+	}
+
+	obj, err := glib.ObjectNew(typ)
+	if err != nil {
+		t.Fatal("ObjectNew failed:", err)
+	}
+	v, _ := obj.GoValue()
+	thing := v.(*testThing)
+
+	glib.ConnectTyped(thing, "poke", func(th *testThing) {
+		th.counter++
+	})
+	glib.ConnectTyped1(thing, "add", func(th *testThing, n int) {
+		th.counter += n
+	})
+	glib.ConnectTyped1Return(thing, "describe", func(th *testThing, n int) string {
+		if n == th.counter {
+			return "same"
+		}
+		return "different"
+	})
+
+	if _, err := obj.Emit("poke", glib.TYPE_NONE); err != nil {
+		t.Fatal("Emit failed:", err)
+	}
+	if _, err := obj.Emit("add", glib.TYPE_NONE, 41); err != nil {
+		t.Fatal("Emit failed:", err)
+	}
+	if thing.counter != 42 {
+		t.Errorf("Expected 42, got %d", thing.counter)
+	}
+
+	ret, err := obj.Emit("describe", glib.TYPE_STRING, 42)
+	if err != nil {
+		t.Fatal("Emit failed:", err)
+	}
+	if ret.(string) != "same" {
+		t.Errorf("Expected \"same\", got %q", ret)
+	}
+}
+
+func TestConnectMarshal(t *testing.T) {
+	typ, err := glib.RegisterType("GotkTestMarshalThing", glib.TYPE_OBJECT, &glib.TypeInfo{
+		InstanceInit: func(obj *glib.Object) *testThing {
+			return &testThing{Object: obj}
+		},
+	})
+	if err != nil {
+		t.Fatal("RegisterType failed:", err)
+	}
+
+	_, err = glib.SignalNewFull("check", typ, glib.SIGNAL_RUN_LAST, nil, nil, glib.TYPE_BOOLEAN, glib.TYPE_INT)
+	if err != nil {
+		t.Fatal("SignalNewFull failed:", err)
+	}
+
+	obj, err := glib.ObjectNew(typ)
+	if err != nil {
+		t.Fatal("ObjectNew failed:", err)
+	}
+
+	obj.ConnectMarshal("check", func(ret *glib.Value, params []glib.Value) {
+		if len(params) != 2 {
+			t.Errorf("Expected 2 parameters, got %d", len(params))
+			return
+		}
+		n, _ := params[1].GoValue()
+		ret.SetBool(n.(int) > 0)
+	})
+
+	ret, err := obj.Emit("check", glib.TYPE_BOOLEAN, 3)
+	if err != nil {
+		t.Fatal("Emit failed:", err)
+	}
+	if !ret.(bool) {
+		t.Error("Expected true")
+	}
+}
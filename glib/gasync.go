@@ -0,0 +1,55 @@
+package glib
+
+import (
+	"context"
+	"unsafe"
+)
+
+// AsyncFunc starts an asynchronous operation, such as
+// File.LoadContentsAsync with its other arguments bound, and calls fn once
+// it completes. fn is called in the thread-default main context of the
+// goroutine starting the operation, usually the default main context run
+// by gtk.Main or MainLoop.Run.
+type AsyncFunc func(cancellable *Cancellable, fn AsyncReadyCallback)
+
+// AsyncChan starts op and returns a channel receiving its result, to be
+// passed to the corresponding finish function, once it completes.
+// cancellable may be nil. The channel is buffered, so the main context is
+// never blocked by a missing receiver.
+func AsyncChan(op AsyncFunc, cancellable *Cancellable) <-chan *AsyncResult {
+	ch := make(chan *AsyncResult, 1)
+	op(cancellable, func(_ *Object, res *AsyncResult) {
+		// The result is only valid during the callback unless referenced.
+		ch <- wrapAsyncResult(Take(unsafe.Pointer(res.native())))
+	})
+	return ch
+}
+
+// AsyncContext starts op with a Cancellable which is cancelled when ctx is
+// done, and waits for op to complete. If ctx is done first, ctx.Err() is
+// returned along with the result, whose finish function then usually
+// reports the cancellation.
+//
+// AsyncContext blocks until the main context dispatching the completion
+// runs, so it must not be called from the goroutine running that main
+// context, e.g. from a signal handler.
+func AsyncContext(ctx context.Context, op AsyncFunc) (*AsyncResult, error) {
+	cancellable, err := CancellableNew()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		cancellable.Cancel()
+		return <-AsyncChan(op, cancellable), err
+	}
+
+	ch := AsyncChan(op, cancellable)
+	select {
+	case res := <-ch:
+		return res, nil
+	case <-ctx.Done():
+		cancellable.Cancel()
+		return <-ch, ctx.Err()
+	}
+}
@@ -0,0 +1,28 @@
+//go:build go1.18
+// +build go1.18
+
+package glib
+
+import "context"
+
+// Await runs op like AsyncContext and returns the result of finish, the
+// finish function matching op, e.g.
+//
+//	stream, err := glib.Await(ctx, func(c *glib.Cancellable, fn glib.AsyncReadyCallback) {
+//		file.ReadAsync(glib.PRIORITY_DEFAULT, c, fn)
+//	}, file.ReadFinish)
+//
+// If ctx is done before op completes, ctx.Err() is returned.
+func Await[T any](ctx context.Context, op AsyncFunc, finish func(*AsyncResult) (T, error)) (T, error) {
+	res, err := AsyncContext(ctx, op)
+	if res == nil {
+		var zero T
+		return zero, err
+	}
+
+	v, finishErr := finish(res)
+	if err != nil {
+		return v, err
+	}
+	return v, finishErr
+}
@@ -0,0 +1,76 @@
+//go:build go1.18
+// +build go1.18
+
+package glib_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func TestAsyncFile(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "gotk3-gasync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	file, _ := glib.FileNewForPath(filepath.Join(tmp, "async.txt"))
+	mainLoop := glib.MainLoopNew(glib.MainContextDefault(), false)
+
+	file.ReplaceContentsAsync([]byte("async contents"), "", false, glib.FILE_CREATE_NONE, nil,
+		func(_ *glib.Object, res *glib.AsyncResult) {
+			_, err = file.ReplaceContentsFinish(res)
+			mainLoop.Quit()
+		})
+	mainLoop.Run()
+	if err != nil {
+		t.Fatal("ReplaceContentsAsync failed:", err)
+	}
+
+	// Await blocks, so it runs in another goroutine while the main loop
+	// dispatches the completions.
+	var (
+		data      []byte
+		cancelErr error
+	)
+	go func() {
+		defer glib.IdleAdd(mainLoop.Quit)
+
+		stream, err := glib.Await(context.Background(), func(c *glib.Cancellable, fn glib.AsyncReadyCallback) {
+			file.ReadAsync(glib.PRIORITY_DEFAULT, c, fn)
+		}, file.ReadFinish)
+		if err != nil {
+			t.Error("ReadAsync failed:", err)
+			return
+		}
+		data, err = glib.Await(context.Background(), func(c *glib.Cancellable, fn glib.AsyncReadyCallback) {
+			stream.ReadBytesAsync(64, glib.PRIORITY_DEFAULT, c, fn)
+		}, stream.ReadBytesFinish)
+		if err != nil {
+			t.Error("ReadBytesAsync failed:", err)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, cancelErr = glib.Await(ctx, func(c *glib.Cancellable, fn glib.AsyncReadyCallback) {
+			file.LoadContentsAsync(c, fn)
+		}, func(res *glib.AsyncResult) ([]byte, error) {
+			contents, _, err := file.LoadContentsFinish(res)
+			return contents, err
+		})
+	}()
+	mainLoop.Run()
+
+	if string(data) != "async contents" {
+		t.Errorf("Expected \"async contents\", got %q", data)
+	}
+	if cancelErr == nil {
+		t.Error("Expected an error for a cancelled context")
+	}
+}
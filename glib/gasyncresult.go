@@ -6,7 +6,6 @@ package glib
 // #include "glib.go.h"
 import "C"
 import (
-	"errors"
 	"unsafe"
 )
 
@@ -66,8 +65,7 @@ func (v *AsyncResult) LegacyPropagateError() error {
 	c := C.g_async_result_legacy_propagate_error(v.native(), &err)
 	isSimpleAsyncResult := gobool(c)
 	if isSimpleAsyncResult {
-		defer C.g_error_free(err)
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return takeError(err)
 	}
 	return nil
 }
@@ -6,7 +6,6 @@ package glib
 // #include "glib.go.h"
 import "C"
 import (
-	"errors"
 	"unsafe"
 )
 
@@ -53,8 +52,7 @@ func (v *Cancellable) SetErrorIfCancelled() error {
 	c := C.g_cancellable_set_error_if_cancelled(v.native(), &err)
 	cancelled := gobool(c)
 	if cancelled {
-		defer C.g_error_free(err)
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return takeError(err)
 	}
 	return nil
 }
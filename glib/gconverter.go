@@ -0,0 +1,360 @@
+package glib
+
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "giostream.go.h"
+import "C"
+import (
+	"unsafe"
+)
+
+func init() {
+
+	tm := []TypeMarshaler{
+		{Type(C.g_converter_get_type()), marshalConverter},
+		{Type(C.g_zlib_compressor_get_type()), marshalZlibCompressor},
+		{Type(C.g_zlib_decompressor_get_type()), marshalZlibDecompressor},
+		{Type(C.g_charset_converter_get_type()), marshalCharsetConverter},
+		{Type(C.g_converter_input_stream_get_type()), marshalConverterInputStream},
+		{Type(C.g_converter_output_stream_get_type()), marshalConverterOutputStream},
+
+		// Enums
+		{Type(C.g_zlib_compressor_format_get_type()), marshalZlibCompressorFormat},
+	}
+
+	RegisterGValueMarshalers(tm)
+}
+
+// ZlibCompressorFormat is a representation of GIO's GZlibCompressorFormat.
+type ZlibCompressorFormat int
+
+const (
+	ZLIB_COMPRESSOR_FORMAT_ZLIB ZlibCompressorFormat = C.G_ZLIB_COMPRESSOR_FORMAT_ZLIB
+	ZLIB_COMPRESSOR_FORMAT_GZIP ZlibCompressorFormat = C.G_ZLIB_COMPRESSOR_FORMAT_GZIP
+	ZLIB_COMPRESSOR_FORMAT_RAW  ZlibCompressorFormat = C.G_ZLIB_COMPRESSOR_FORMAT_RAW
+)
+
+func marshalZlibCompressorFormat(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return ZlibCompressorFormat(c), nil
+}
+
+/*
+ * GConverter
+ */
+
+// Converter is a representation of GIO's GConverter GInterface.
+type Converter struct {
+	*Object
+}
+
+// IConverter is an interface type implemented by all structs embedding a
+// Converter. It is meant to be used as an argument type for wrapper
+// functions that wrap around a C function taking a GConverter.
+type IConverter interface {
+	toGConverter() *C.GConverter
+}
+
+func (v *Converter) toGConverter() *C.GConverter {
+	if v == nil {
+		return nil
+	}
+	return v.native()
+}
+
+// native returns a pointer to the underlying GConverter.
+func (v *Converter) native() *C.GConverter {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGConverter(p)
+}
+
+// Native returns a pointer to the underlying GConverter.
+func (v *Converter) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalConverter(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapConverter(obj), nil
+}
+
+func wrapConverter(obj *Object) *Converter {
+	return &Converter{obj}
+}
+
+// Reset is a wrapper around g_converter_reset().
+func (v *Converter) Reset() {
+	C.g_converter_reset(v.native())
+}
+
+/*
+ * GZlibCompressor
+ */
+
+// ZlibCompressor is a representation of GIO's GZlibCompressor.
+// Zlib compressor
+type ZlibCompressor struct {
+	*Converter
+}
+
+// native returns a pointer to the underlying GZlibCompressor.
+func (v *ZlibCompressor) native() *C.GZlibCompressor {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGZlibCompressor(p)
+}
+
+// Native returns a pointer to the underlying GZlibCompressor.
+func (v *ZlibCompressor) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalZlibCompressor(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapZlibCompressor(obj), nil
+}
+
+func wrapZlibCompressor(obj *Object) *ZlibCompressor {
+	return &ZlibCompressor{wrapConverter(obj)}
+}
+
+// ZlibCompressorNew is a wrapper around g_zlib_compressor_new(). A level of
+// -1 selects the default compression level.
+func ZlibCompressorNew(format ZlibCompressorFormat, level int) *ZlibCompressor {
+	c := C.g_zlib_compressor_new(C.GZlibCompressorFormat(format), C.int(level))
+	return wrapZlibCompressor(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// GetFileInfo is a wrapper around g_zlib_compressor_get_file_info().
+func (v *ZlibCompressor) GetFileInfo() *FileInfo {
+	c := C.g_zlib_compressor_get_file_info(v.native())
+	if c == nil {
+		return nil
+	}
+	return wrapFileInfo(Take(unsafe.Pointer(c)))
+}
+
+// SetFileInfo is a wrapper around g_zlib_compressor_set_file_info(). The
+// name and modification time of info are stored in the gzip header.
+func (v *ZlibCompressor) SetFileInfo(info *FileInfo) {
+	C.g_zlib_compressor_set_file_info(v.native(), info.native())
+}
+
+/*
+ * GZlibDecompressor
+ */
+
+// ZlibDecompressor is a representation of GIO's GZlibDecompressor.
+// Zlib decompressor
+type ZlibDecompressor struct {
+	*Converter
+}
+
+// native returns a pointer to the underlying GZlibDecompressor.
+func (v *ZlibDecompressor) native() *C.GZlibDecompressor {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGZlibDecompressor(p)
+}
+
+// Native returns a pointer to the underlying GZlibDecompressor.
+func (v *ZlibDecompressor) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalZlibDecompressor(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapZlibDecompressor(obj), nil
+}
+
+func wrapZlibDecompressor(obj *Object) *ZlibDecompressor {
+	return &ZlibDecompressor{wrapConverter(obj)}
+}
+
+// ZlibDecompressorNew is a wrapper around g_zlib_decompressor_new().
+func ZlibDecompressorNew(format ZlibCompressorFormat) *ZlibDecompressor {
+	c := C.g_zlib_decompressor_new(C.GZlibCompressorFormat(format))
+	return wrapZlibDecompressor(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// GetFileInfo is a wrapper around g_zlib_decompressor_get_file_info(). It
+// returns nil unless the data is in the gzip format and its header has been
+// read.
+func (v *ZlibDecompressor) GetFileInfo() *FileInfo {
+	c := C.g_zlib_decompressor_get_file_info(v.native())
+	if c == nil {
+		return nil
+	}
+	return wrapFileInfo(Take(unsafe.Pointer(c)))
+}
+
+/*
+ * GCharsetConverter
+ */
+
+// CharsetConverter is a representation of GIO's GCharsetConverter.
+// Convert between charsets
+type CharsetConverter struct {
+	*Converter
+}
+
+// native returns a pointer to the underlying GCharsetConverter.
+func (v *CharsetConverter) native() *C.GCharsetConverter {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGCharsetConverter(p)
+}
+
+// Native returns a pointer to the underlying GCharsetConverter.
+func (v *CharsetConverter) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalCharsetConverter(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapCharsetConverter(obj), nil
+}
+
+func wrapCharsetConverter(obj *Object) *CharsetConverter {
+	return &CharsetConverter{wrapConverter(obj)}
+}
+
+// CharsetConverterNew is a wrapper around g_charset_converter_new().
+func CharsetConverterNew(toCharset, fromCharset string) (*CharsetConverter, error) {
+	cto := (*C.gchar)(C.CString(toCharset))
+	defer C.free(unsafe.Pointer(cto))
+	cfrom := (*C.gchar)(C.CString(fromCharset))
+	defer C.free(unsafe.Pointer(cfrom))
+
+	var gerr *C.GError
+	c := C.g_charset_converter_new(cto, cfrom, &gerr)
+	if c == nil {
+		return nil, takeError(gerr)
+	}
+	return wrapCharsetConverter(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// GetUseFallback is a wrapper around g_charset_converter_get_use_fallback().
+func (v *CharsetConverter) GetUseFallback() bool {
+	return gobool(C.g_charset_converter_get_use_fallback(v.native()))
+}
+
+// SetUseFallback is a wrapper around g_charset_converter_set_use_fallback().
+func (v *CharsetConverter) SetUseFallback(useFallback bool) {
+	C.g_charset_converter_set_use_fallback(v.native(), gbool(useFallback))
+}
+
+// GetNumFallbacks is a wrapper around g_charset_converter_get_num_fallbacks().
+func (v *CharsetConverter) GetNumFallbacks() uint {
+	return uint(C.g_charset_converter_get_num_fallbacks(v.native()))
+}
+
+/*
+ * GConverterInputStream
+ */
+
+// ConverterInputStream is a representation of GIO's GConverterInputStream.
+// Converter Input Stream
+type ConverterInputStream struct {
+	*FilterInputStream
+}
+
+// native returns a pointer to the underlying GConverterInputStream.
+func (v *ConverterInputStream) native() *C.GConverterInputStream {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGConverterInputStream(p)
+}
+
+// Native returns a pointer to the underlying GConverterInputStream.
+func (v *ConverterInputStream) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalConverterInputStream(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapConverterInputStream(obj), nil
+}
+
+func wrapConverterInputStream(obj *Object) *ConverterInputStream {
+	return &ConverterInputStream{wrapFilterInputStream(obj)}
+}
+
+// ConverterInputStreamNew is a wrapper around g_converter_input_stream_new().
+// Data read from baseStream is converted by converter, for example
+// decompressed by a ZlibDecompressor.
+func ConverterInputStreamNew(baseStream *InputStream, converter IConverter) *ConverterInputStream {
+	c := C.g_converter_input_stream_new(baseStream.native(), converter.toGConverter())
+	return wrapConverterInputStream(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// GetConverter is a wrapper around g_converter_input_stream_get_converter().
+func (v *ConverterInputStream) GetConverter() *Converter {
+	c := C.g_converter_input_stream_get_converter(v.native())
+	return wrapConverter(Take(unsafe.Pointer(c)))
+}
+
+/*
+ * GConverterOutputStream
+ */
+
+// ConverterOutputStream is a representation of GIO's GConverterOutputStream.
+// Converter Output Stream
+type ConverterOutputStream struct {
+	*FilterOutputStream
+}
+
+// native returns a pointer to the underlying GConverterOutputStream.
+func (v *ConverterOutputStream) native() *C.GConverterOutputStream {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGConverterOutputStream(p)
+}
+
+// Native returns a pointer to the underlying GConverterOutputStream.
+func (v *ConverterOutputStream) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalConverterOutputStream(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapConverterOutputStream(obj), nil
+}
+
+func wrapConverterOutputStream(obj *Object) *ConverterOutputStream {
+	return &ConverterOutputStream{wrapFilterOutputStream(obj)}
+}
+
+// ConverterOutputStreamNew is a wrapper around
+// g_converter_output_stream_new(). Data written to the stream is converted
+// by converter before being written to baseStream.
+func ConverterOutputStreamNew(baseStream *OutputStream, converter IConverter) *ConverterOutputStream {
+	c := C.g_converter_output_stream_new(baseStream.native(), converter.toGConverter())
+	return wrapConverterOutputStream(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// GetConverter is a wrapper around g_converter_output_stream_get_converter().
+func (v *ConverterOutputStream) GetConverter() *Converter {
+	c := C.g_converter_output_stream_get_converter(v.native())
+	return wrapConverter(Take(unsafe.Pointer(c)))
+}
@@ -0,0 +1,66 @@
+package glib_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func TestZlibConverterStreams(t *testing.T) {
+	text := strings.Repeat("gotk3 converter streams ", 100)
+
+	// Compress with GIO and decompress with Go.
+	mem := glib.MemoryOutputStreamNewResizable()
+	out := glib.ConverterOutputStreamNew(mem.OutputStream, glib.ZlibCompressorNew(glib.ZLIB_COMPRESSOR_FORMAT_GZIP, -1))
+	w := out.AsWriter(nil)
+	if _, err := w.Write([]byte(text)); err != nil {
+		t.Fatal("Write failed:", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal("Close failed:", err)
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(mem.GetData()))
+	if err != nil {
+		t.Fatal("gzip.NewReader failed:", err)
+	}
+	if b, err := ioutil.ReadAll(zr); err != nil || string(b) != text {
+		t.Errorf("Unexpected gzip contents %q, %v", b, err)
+	}
+
+	// Compress with Go and decompress with GIO.
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Name = "test.txt"
+	zw.Write([]byte(text))
+	zw.Close()
+
+	decompressor := glib.ZlibDecompressorNew(glib.ZLIB_COMPRESSOR_FORMAT_GZIP)
+	in := glib.ConverterInputStreamNew(glib.MemoryInputStreamNewFromBytes(buf.Bytes()).InputStream, decompressor)
+	if b, err := ioutil.ReadAll(in.AsReader(nil)); err != nil || string(b) != text {
+		t.Errorf("Unexpected decompressed contents %q, %v", b, err)
+	}
+	if info := decompressor.GetFileInfo(); info == nil || info.GetName() != "test.txt" {
+		t.Error("Expected the gzip header file name")
+	}
+}
+
+func TestCharsetConverter(t *testing.T) {
+	converter, err := glib.CharsetConverterNew("UTF-8", "ISO-8859-1")
+	if err != nil {
+		t.Fatal("CharsetConverterNew failed:", err)
+	}
+
+	in := glib.ConverterInputStreamNew(glib.MemoryInputStreamNewFromBytes([]byte{'c', 'a', 'f', 0xe9}).InputStream, converter)
+	if b, err := ioutil.ReadAll(in.AsReader(nil)); err != nil || string(b) != "café" {
+		t.Errorf("Unexpected converted contents %q, %v", b, err)
+	}
+
+	if _, err := glib.CharsetConverterNew("UTF-8", "NO-SUCH-CHARSET"); err == nil {
+		t.Error("Expected an error for an unknown charset")
+	}
+}
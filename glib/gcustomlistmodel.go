@@ -0,0 +1,143 @@
+// Same copyright and license as the rest of the files in this project
+
+// +build !glib_2_40,!glib_2_42
+
+package glib
+
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+// #include "gcustomlistmodel.go.h"
+import "C"
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/internal/callback"
+)
+
+/*
+ * GListModel implemented in Go
+ */
+
+// ListModelImplementor is implemented by Go types providing the items of a
+// CustomListModel. Its methods are the GListModelInterface virtual methods.
+type ListModelImplementor interface {
+	// GetItemType returns the type of the items, which must derive from
+	// TYPE_OBJECT.
+	GetItemType() Type
+	GetNItems() uint
+	// GetItem returns the item at position, or nil if position is out of
+	// range.
+	GetItem(position uint) *Object
+}
+
+// CustomListModel is a GListModel whose items are provided by a
+// ListModelImplementor. Implementors must call ItemsChanged whenever their
+// items change.
+type CustomListModel struct {
+	ListModel
+}
+
+func wrapCustomListModel(obj *Object) *CustomListModel {
+	return &CustomListModel{ListModel{obj}}
+}
+
+// CustomListModelNew creates a GListModel backed by impl. impl is kept alive
+// until the model is finalized.
+func CustomListModelNew(impl ListModelImplementor) (*CustomListModel, error) {
+	id := callback.Assign(impl)
+	c := C._gotk_custom_list_model_new(C.guint(id))
+	if c == nil {
+		callback.Delete(id)
+		return nil, nilPtrErr
+	}
+	return wrapCustomListModel(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+func customListModelImplementor(id C.guint) ListModelImplementor {
+	return callback.Get(uintptr(id)).(ListModelImplementor)
+}
+
+//export goCustomListModelFinalize
+func goCustomListModelFinalize(id C.guint) {
+	callback.Delete(uintptr(id))
+}
+
+//export goCustomListModelGetItemType
+func goCustomListModelGetItemType(id C.guint) C.GType {
+	return C.GType(customListModelImplementor(id).GetItemType())
+}
+
+//export goCustomListModelGetNItems
+func goCustomListModelGetNItems(id C.guint) C.guint {
+	return C.guint(customListModelImplementor(id).GetNItems())
+}
+
+//export goCustomListModelGetItem
+func goCustomListModelGetItem(id C.guint, position C.guint) C.gpointer {
+	obj := customListModelImplementor(id).GetItem(uint(position))
+	if obj == nil || obj.GObject == nil {
+		return nil
+	}
+	// The caller owns the returned reference.
+	return C.g_object_ref(C.gpointer(obj.native()))
+}
+
+/*
+ * ListItem
+ */
+
+// ListItem is a GObject holding an arbitrary Go value, so that plain Go
+// values can be stored in a GListModel.
+type ListItem struct {
+	*Object
+	value interface{}
+}
+
+// Value returns the Go value held by the ListItem.
+func (v *ListItem) Value() interface{} {
+	return v.value
+}
+
+var listItemType struct {
+	sync.Once
+	t   Type
+	err error
+}
+
+// ListItemGetType returns the type of the objects created by ListItemNew,
+// registering it on first use.
+func ListItemGetType() (Type, error) {
+	listItemType.Do(func() {
+		listItemType.t, listItemType.err = RegisterType("GotkListItem", TYPE_OBJECT, &TypeInfo{
+			InstanceInit: func(obj *Object) *ListItem {
+				return &ListItem{Object: obj}
+			},
+		})
+	})
+	return listItemType.t, listItemType.err
+}
+
+// ListItemNew creates a new GObject holding value. Its GoValue is the
+// *ListItem giving access to value. The *ListItem does not keep the GObject
+// alive by itself, keep the returned Object instead.
+func ListItemNew(value interface{}) (*Object, error) {
+	t, err := ListItemGetType()
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := ObjectNew(t)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := obj.GoValue()
+	if err != nil {
+		return nil, err
+	}
+	item.(*ListItem).value = value
+	return obj, nil
+}
@@ -0,0 +1,88 @@
+// Same copyright and license as the rest of the files in this project
+
+#ifndef __GCUSTOMLISTMODEL_GO_H__
+#define __GCUSTOMLISTMODEL_GO_H__
+
+#include <gio/gio.h>
+#include <glib-object.h>
+#include <glib.h>
+#include <stdlib.h>
+
+/*
+ * GotkCustomListModel is a GObject implementing GListModel by forwarding
+ * every method to a Go ListModelImplementor, found with the callback id
+ * stored in the instance.
+ */
+typedef struct {
+  GObject parent;
+  guint id;
+} GotkCustomListModel;
+
+typedef struct {
+  GObjectClass parent_class;
+} GotkCustomListModelClass;
+
+extern void goCustomListModelFinalize(guint id);
+extern GType goCustomListModelGetItemType(guint id);
+extern guint goCustomListModelGetNItems(guint id);
+extern gpointer goCustomListModelGetItem(guint id, guint position);
+
+static GType _gotk_custom_list_model_get_item_type(GListModel *list) {
+  return goCustomListModelGetItemType(((GotkCustomListModel *)list)->id);
+}
+
+static guint _gotk_custom_list_model_get_n_items(GListModel *list) {
+  return goCustomListModelGetNItems(((GotkCustomListModel *)list)->id);
+}
+
+static gpointer _gotk_custom_list_model_get_item(GListModel *list,
+                                                 guint position) {
+  return goCustomListModelGetItem(((GotkCustomListModel *)list)->id, position);
+}
+
+static void _gotk_custom_list_model_init_iface(GListModelInterface *iface) {
+  iface->get_item_type = _gotk_custom_list_model_get_item_type;
+  iface->get_n_items = _gotk_custom_list_model_get_n_items;
+  iface->get_item = _gotk_custom_list_model_get_item;
+}
+
+static GObjectClass *_gotk_custom_list_model_parent_class = NULL;
+
+static void _gotk_custom_list_model_finalize(GObject *object) {
+  goCustomListModelFinalize(((GotkCustomListModel *)object)->id);
+  _gotk_custom_list_model_parent_class->finalize(object);
+}
+
+static void _gotk_custom_list_model_class_init(gpointer g_class,
+                                               gpointer class_data) {
+  _gotk_custom_list_model_parent_class = g_type_class_peek_parent(g_class);
+  G_OBJECT_CLASS(g_class)->finalize = _gotk_custom_list_model_finalize;
+}
+
+static GType _gotk_custom_list_model_get_type() {
+  static gsize type_id = 0;
+
+  if (g_once_init_enter(&type_id)) {
+    GType t = g_type_register_static_simple(
+        G_TYPE_OBJECT, "GotkCustomListModel",
+        sizeof(GotkCustomListModelClass), _gotk_custom_list_model_class_init,
+        sizeof(GotkCustomListModel), NULL, 0);
+
+    GInterfaceInfo iface_info = {
+        (GInterfaceInitFunc)_gotk_custom_list_model_init_iface, NULL, NULL};
+    g_type_add_interface_static(t, G_TYPE_LIST_MODEL, &iface_info);
+
+    g_once_init_leave(&type_id, t);
+  }
+
+  return type_id;
+}
+
+static GotkCustomListModel *_gotk_custom_list_model_new(guint id) {
+  GotkCustomListModel *model =
+      g_object_new(_gotk_custom_list_model_get_type(), NULL);
+  model->id = id;
+  return model;
+}
+
+#endif
@@ -0,0 +1,148 @@
+//go:build go1.18 && !glib_2_40 && !glib_2_42
+// +build go1.18,!glib_2_40,!glib_2_42
+
+package glib
+
+import "fmt"
+
+/*
+ * SliceListModel
+ */
+
+// SliceListModel is a GListModel holding a slice of Go values. Each item is
+// handed to GTK as a ListItem, whose Value is the Go value, so the model can
+// be bound to widgets like gtk.ListBox without defining a GObject type for
+// the items. Modifying the model emits "items-changed".
+type SliceListModel[T any] struct {
+	*CustomListModel
+	impl *sliceListModelImpl[T]
+}
+
+type sliceListModelImpl[T any] struct {
+	items []T
+
+	// objects caches the ListItem of each item, so that GetItem returns
+	// the same object for the same item. Entries are created on demand.
+	objects []*Object
+}
+
+func (v *sliceListModelImpl[T]) GetItemType() Type {
+	t, _ := ListItemGetType()
+	return t
+}
+
+func (v *sliceListModelImpl[T]) GetNItems() uint {
+	return uint(len(v.items))
+}
+
+func (v *sliceListModelImpl[T]) GetItem(position uint) *Object {
+	if position >= uint(len(v.items)) {
+		return nil
+	}
+	if obj := v.objects[position]; obj != nil {
+		return obj
+	}
+	obj, err := ListItemNew(v.items[position])
+	if err != nil {
+		return nil
+	}
+	v.objects[position] = obj
+	return obj
+}
+
+// SliceListModelNew creates a SliceListModel holding items.
+func SliceListModelNew[T any](items ...T) (*SliceListModel[T], error) {
+	if _, err := ListItemGetType(); err != nil {
+		return nil, err
+	}
+
+	impl := &sliceListModelImpl[T]{
+		items:   append([]T(nil), items...),
+		objects: make([]*Object, len(items)),
+	}
+	model, err := CustomListModelNew(impl)
+	if err != nil {
+		return nil, err
+	}
+	return &SliceListModel[T]{model, impl}, nil
+}
+
+// Len returns the number of items in the model.
+func (v *SliceListModel[T]) Len() uint {
+	return uint(len(v.impl.items))
+}
+
+// Get returns the item at position, and false if position is out of range.
+func (v *SliceListModel[T]) Get(position uint) (T, bool) {
+	if position >= v.Len() {
+		var zero T
+		return zero, false
+	}
+	return v.impl.items[position], true
+}
+
+// Items returns a copy of the items of the model.
+func (v *SliceListModel[T]) Items() []T {
+	return append([]T(nil), v.impl.items...)
+}
+
+// Splice removes nRemovals items at position and inserts additions in
+// their place, like ListStore.Splice. As with g_list_store_splice(), a
+// critical is logged and nothing is changed if position is past the end of
+// the model; nRemovals is reduced to the number of items after position.
+func (v *SliceListModel[T]) Splice(position, nRemovals uint, additions ...T) {
+	n := v.Len()
+	if position > n {
+		Log("gotk3", LOG_LEVEL_CRITICAL, fmt.Sprintf("SliceListModel.Splice: position %d out of range for %d items", position, n))
+		return
+	}
+	if nRemovals > n-position {
+		nRemovals = n - position
+	}
+
+	end := position + nRemovals
+	v.impl.items = splice(v.impl.items, position, end, additions)
+	v.impl.objects = splice(v.impl.objects, position, end, make([]*Object, len(additions)))
+
+	v.ItemsChanged(position, nRemovals, uint(len(additions)))
+}
+
+// splice returns s with s[start:end] replaced by additions.
+func splice[E any](s []E, start, end uint, additions []E) []E {
+	tail := append([]E(nil), s[end:]...)
+	s = append(s[:start], additions...)
+	return append(s, tail...)
+}
+
+// Set replaces the item at position. A critical is logged and nothing is
+// changed if position is out of range.
+func (v *SliceListModel[T]) Set(position uint, item T) {
+	if position >= v.Len() {
+		Log("gotk3", LOG_LEVEL_CRITICAL, fmt.Sprintf("SliceListModel.Set: position %d out of range for %d items", position, v.Len()))
+		return
+	}
+	v.Splice(position, 1, item)
+}
+
+// Insert inserts item at position.
+func (v *SliceListModel[T]) Insert(position uint, item T) {
+	v.Splice(position, 0, item)
+}
+
+// Append adds items at the end of the model.
+func (v *SliceListModel[T]) Append(items ...T) {
+	v.Splice(v.Len(), 0, items...)
+}
+
+// Remove removes the item at position. It does nothing if position is out
+// of range.
+func (v *SliceListModel[T]) Remove(position uint) {
+	if position < v.Len() {
+		v.Splice(position, 1)
+	}
+}
+
+// RemoveAll removes all items from the model.
+func (v *SliceListModel[T]) RemoveAll() {
+	v.Splice(0, v.Len())
+}
@@ -0,0 +1,109 @@
+//go:build go1.18 && !glib_2_40 && !glib_2_42
+// +build go1.18,!glib_2_40,!glib_2_42
+
+package glib_test
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+type testRow struct {
+	name string
+}
+
+func TestSliceListModel(t *testing.T) {
+	model, err := glib.SliceListModelNew(testRow{"a"}, testRow{"b"})
+	if err != nil {
+		t.Fatal("SliceListModelNew failed:", err)
+	}
+
+	type change struct{ position, removed, added uint }
+	var changes []change
+	model.Connect("items-changed", func(_ *glib.Object, position, removed, added uint) {
+		changes = append(changes, change{position, removed, added})
+	})
+
+	model.Append(testRow{"c"}, testRow{"d"})
+	model.Remove(0)
+	model.Set(1, testRow{"C"})
+
+	expected := []change{{2, 0, 2}, {0, 1, 0}, {1, 1, 1}}
+	if len(changes) != len(expected) {
+		t.Fatalf("Expected %d items-changed emissions, got %d", len(expected), len(changes))
+	}
+	for i := range expected {
+		if changes[i] != expected[i] {
+			t.Errorf("Emission %d: expected %v, got %v", i, expected[i], changes[i])
+		}
+	}
+
+	if n := model.GetNItems(); n != 3 {
+		t.Fatalf("Expected 3 items, got %d", n)
+	}
+
+	itemType, err := glib.ListItemGetType()
+	if err != nil {
+		t.Fatal("ListItemGetType failed:", err)
+	}
+	if model.GetItemType() != itemType {
+		t.Errorf("Expected item type %s, got %s", itemType.Name(), model.GetItemType().Name())
+	}
+
+	obj := model.GetObject(1)
+	if obj == nil {
+		t.Fatal("Expected item at position 1")
+	}
+	v, err := obj.GoValue()
+	if err != nil {
+		t.Fatal("GoValue failed:", err)
+	}
+	item, ok := v.(*glib.ListItem)
+	if !ok {
+		t.Fatalf("Expected *glib.ListItem, got %T", v)
+	}
+	if row := item.Value().(testRow); row.name != "C" {
+		t.Errorf("Expected C, got %s", row.name)
+	}
+}
+
+func TestSliceListModelBounds(t *testing.T) {
+	model, err := glib.SliceListModelNew[testRow]()
+	if err != nil {
+		t.Fatal("SliceListModelNew failed:", err)
+	}
+
+	if _, ok := model.Get(0); ok {
+		t.Error("Get succeeded on an empty model")
+	}
+	model.Remove(0)
+
+	model.Append(testRow{"a"}, testRow{"b"})
+	model.Splice(1, 5, testRow{"c"})
+	if items := model.Items(); len(items) != 2 || items[1].name != "c" {
+		t.Errorf("Expected [a c], got %v", items)
+	}
+}
+
+func TestSliceListModelItemIdentity(t *testing.T) {
+	model, err := glib.SliceListModelNew(testRow{"a"}, testRow{"b"})
+	if err != nil {
+		t.Fatal("SliceListModelNew failed:", err)
+	}
+
+	a := model.GetObject(0)
+	if a == nil || model.GetObject(0).Native() != a.Native() {
+		t.Fatal("Expected the same object for repeated lookups")
+	}
+
+	model.Insert(0, testRow{"z"})
+	if model.GetObject(1).Native() != a.Native() {
+		t.Error("Expected the object to move with its item")
+	}
+
+	model.Set(1, testRow{"A"})
+	if model.GetObject(1).Native() == a.Native() {
+		t.Error("Expected a new object for a replaced item")
+	}
+}
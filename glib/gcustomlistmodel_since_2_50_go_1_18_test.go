@@ -0,0 +1,36 @@
+//go:build go1.18 && !glib_2_40 && !glib_2_42 && !glib_2_44 && !glib_2_46 && !glib_2_48
+// +build go1.18,!glib_2_40,!glib_2_42,!glib_2_44,!glib_2_46,!glib_2_48
+
+package glib_test
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func TestSliceListModelOutOfRange(t *testing.T) {
+	model, err := glib.SliceListModelNew(testRow{"a"})
+	if err != nil {
+		t.Fatal("SliceListModelNew failed:", err)
+	}
+
+	var criticals int
+	glib.SetLogWriter(func(level glib.LogLevelFlags, fields glib.LogFields) glib.LogWriterOutput {
+		if fields.Domain() != "gotk3" || level&glib.LOG_LEVEL_CRITICAL == 0 {
+			return glib.LOG_WRITER_UNHANDLED
+		}
+		criticals++
+		return glib.LOG_WRITER_HANDLED
+	})
+	defer glib.SetLogWriter(nil)
+
+	model.Set(1, testRow{"b"})
+	model.Splice(2, 0, testRow{"b"})
+	if criticals != 2 {
+		t.Errorf("Expected 2 criticals, got %d", criticals)
+	}
+	if items := model.Items(); len(items) != 1 || items[0].name != "a" {
+		t.Errorf("Expected [a], got %v", items)
+	}
+}
@@ -0,0 +1,520 @@
+package glib
+
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "giostream.go.h"
+import "C"
+import (
+	"io"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/internal/callback"
+)
+
+func init() {
+
+	tm := []TypeMarshaler{
+		{Type(C.g_filter_input_stream_get_type()), marshalFilterInputStream},
+		{Type(C.g_filter_output_stream_get_type()), marshalFilterOutputStream},
+		{Type(C.g_buffered_input_stream_get_type()), marshalBufferedInputStream},
+		{Type(C.g_data_input_stream_get_type()), marshalDataInputStream},
+		{Type(C.g_data_output_stream_get_type()), marshalDataOutputStream},
+
+		// Enums
+		{Type(C.g_data_stream_byte_order_get_type()), marshalDataStreamByteOrder},
+		{Type(C.g_data_stream_newline_type_get_type()), marshalDataStreamNewlineType},
+	}
+
+	RegisterGValueMarshalers(tm)
+}
+
+/*
+ * Enums
+ */
+
+// DataStreamByteOrder is a representation of GIO's GDataStreamByteOrder.
+type DataStreamByteOrder int
+
+const (
+	DATA_STREAM_BYTE_ORDER_BIG_ENDIAN    DataStreamByteOrder = C.G_DATA_STREAM_BYTE_ORDER_BIG_ENDIAN
+	DATA_STREAM_BYTE_ORDER_LITTLE_ENDIAN DataStreamByteOrder = C.G_DATA_STREAM_BYTE_ORDER_LITTLE_ENDIAN
+	DATA_STREAM_BYTE_ORDER_HOST_ENDIAN   DataStreamByteOrder = C.G_DATA_STREAM_BYTE_ORDER_HOST_ENDIAN
+)
+
+func marshalDataStreamByteOrder(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return DataStreamByteOrder(c), nil
+}
+
+// DataStreamNewlineType is a representation of GIO's GDataStreamNewlineType.
+type DataStreamNewlineType int
+
+const (
+	DATA_STREAM_NEWLINE_TYPE_LF    DataStreamNewlineType = C.G_DATA_STREAM_NEWLINE_TYPE_LF
+	DATA_STREAM_NEWLINE_TYPE_CR    DataStreamNewlineType = C.G_DATA_STREAM_NEWLINE_TYPE_CR
+	DATA_STREAM_NEWLINE_TYPE_CR_LF DataStreamNewlineType = C.G_DATA_STREAM_NEWLINE_TYPE_CR_LF
+	DATA_STREAM_NEWLINE_TYPE_ANY   DataStreamNewlineType = C.G_DATA_STREAM_NEWLINE_TYPE_ANY
+)
+
+func marshalDataStreamNewlineType(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return DataStreamNewlineType(c), nil
+}
+
+/*
+ * GFilterInputStream
+ */
+
+// FilterInputStream is a representation of GIO's GFilterInputStream.
+// Base class for input stream implementations that perform some kind of
+// filtering operation on a base stream
+type FilterInputStream struct {
+	*InputStream
+}
+
+// native returns a pointer to the underlying GFilterInputStream.
+func (v *FilterInputStream) native() *C.GFilterInputStream {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGFilterInputStream(p)
+}
+
+// Native returns a pointer to the underlying GFilterInputStream.
+func (v *FilterInputStream) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalFilterInputStream(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapFilterInputStream(obj), nil
+}
+
+func wrapFilterInputStream(obj *Object) *FilterInputStream {
+	return &FilterInputStream{wrapInputStream(obj)}
+}
+
+// GetBaseStream is a wrapper around g_filter_input_stream_get_base_stream().
+func (v *FilterInputStream) GetBaseStream() *InputStream {
+	c := C.g_filter_input_stream_get_base_stream(v.native())
+	return wrapInputStream(Take(unsafe.Pointer(c)))
+}
+
+// GetCloseBaseStream is a wrapper around
+// g_filter_input_stream_get_close_base_stream().
+func (v *FilterInputStream) GetCloseBaseStream() bool {
+	return gobool(C.g_filter_input_stream_get_close_base_stream(v.native()))
+}
+
+// SetCloseBaseStream is a wrapper around
+// g_filter_input_stream_set_close_base_stream().
+func (v *FilterInputStream) SetCloseBaseStream(closeBase bool) {
+	C.g_filter_input_stream_set_close_base_stream(v.native(), gbool(closeBase))
+}
+
+/*
+ * GFilterOutputStream
+ */
+
+// FilterOutputStream is a representation of GIO's GFilterOutputStream.
+// Base class for output stream implementations that perform some kind of
+// filtering operation on a base stream
+type FilterOutputStream struct {
+	*OutputStream
+}
+
+// native returns a pointer to the underlying GFilterOutputStream.
+func (v *FilterOutputStream) native() *C.GFilterOutputStream {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGFilterOutputStream(p)
+}
+
+// Native returns a pointer to the underlying GFilterOutputStream.
+func (v *FilterOutputStream) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalFilterOutputStream(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapFilterOutputStream(obj), nil
+}
+
+func wrapFilterOutputStream(obj *Object) *FilterOutputStream {
+	return &FilterOutputStream{wrapOutputStream(obj)}
+}
+
+// GetBaseStream is a wrapper around g_filter_output_stream_get_base_stream().
+func (v *FilterOutputStream) GetBaseStream() *OutputStream {
+	c := C.g_filter_output_stream_get_base_stream(v.native())
+	return wrapOutputStream(Take(unsafe.Pointer(c)))
+}
+
+// GetCloseBaseStream is a wrapper around
+// g_filter_output_stream_get_close_base_stream().
+func (v *FilterOutputStream) GetCloseBaseStream() bool {
+	return gobool(C.g_filter_output_stream_get_close_base_stream(v.native()))
+}
+
+// SetCloseBaseStream is a wrapper around
+// g_filter_output_stream_set_close_base_stream().
+func (v *FilterOutputStream) SetCloseBaseStream(closeBase bool) {
+	C.g_filter_output_stream_set_close_base_stream(v.native(), gbool(closeBase))
+}
+
+/*
+ * GBufferedInputStream
+ */
+
+// BufferedInputStream is a representation of GIO's GBufferedInputStream.
+// Buffered Input Stream
+type BufferedInputStream struct {
+	*FilterInputStream
+}
+
+// native returns a pointer to the underlying GBufferedInputStream.
+func (v *BufferedInputStream) native() *C.GBufferedInputStream {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGBufferedInputStream(p)
+}
+
+// Native returns a pointer to the underlying GBufferedInputStream.
+func (v *BufferedInputStream) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalBufferedInputStream(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapBufferedInputStream(obj), nil
+}
+
+func wrapBufferedInputStream(obj *Object) *BufferedInputStream {
+	return &BufferedInputStream{wrapFilterInputStream(obj)}
+}
+
+// BufferedInputStreamNew is a wrapper around g_buffered_input_stream_new().
+func BufferedInputStreamNew(baseStream *InputStream) *BufferedInputStream {
+	c := C.g_buffered_input_stream_new(baseStream.native())
+	return wrapBufferedInputStream(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// GetBufferSize is a wrapper around g_buffered_input_stream_get_buffer_size().
+func (v *BufferedInputStream) GetBufferSize() uint {
+	return uint(C.g_buffered_input_stream_get_buffer_size(v.native()))
+}
+
+// SetBufferSize is a wrapper around g_buffered_input_stream_set_buffer_size().
+func (v *BufferedInputStream) SetBufferSize(size uint) {
+	C.g_buffered_input_stream_set_buffer_size(v.native(), C.gsize(size))
+}
+
+// GetAvailable is a wrapper around g_buffered_input_stream_get_available().
+func (v *BufferedInputStream) GetAvailable() uint {
+	return uint(C.g_buffered_input_stream_get_available(v.native()))
+}
+
+// Fill is a wrapper around g_buffered_input_stream_fill(). A count of -1
+// fills the whole buffer.
+func (v *BufferedInputStream) Fill(count int, cancellable *Cancellable) (int, error) {
+	var gerr *C.GError
+	c := C.g_buffered_input_stream_fill(v.native(), C.gssize(count), cancellable.native(), &gerr)
+	if c == -1 {
+		return -1, takeError(gerr)
+	}
+	return int(c), nil
+}
+
+/*
+ * GDataInputStream
+ */
+
+// DataInputStream is a representation of GIO's GDataInputStream.
+// Data Input Stream
+type DataInputStream struct {
+	*BufferedInputStream
+}
+
+// native returns a pointer to the underlying GDataInputStream.
+func (v *DataInputStream) native() *C.GDataInputStream {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGDataInputStream(p)
+}
+
+// Native returns a pointer to the underlying GDataInputStream.
+func (v *DataInputStream) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalDataInputStream(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapDataInputStream(obj), nil
+}
+
+func wrapDataInputStream(obj *Object) *DataInputStream {
+	return &DataInputStream{wrapBufferedInputStream(obj)}
+}
+
+// DataInputStreamNew is a wrapper around g_data_input_stream_new().
+func DataInputStreamNew(baseStream *InputStream) *DataInputStream {
+	c := C.g_data_input_stream_new(baseStream.native())
+	return wrapDataInputStream(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// GetByteOrder is a wrapper around g_data_input_stream_get_byte_order().
+func (v *DataInputStream) GetByteOrder() DataStreamByteOrder {
+	return DataStreamByteOrder(C.g_data_input_stream_get_byte_order(v.native()))
+}
+
+// SetByteOrder is a wrapper around g_data_input_stream_set_byte_order().
+func (v *DataInputStream) SetByteOrder(order DataStreamByteOrder) {
+	C.g_data_input_stream_set_byte_order(v.native(), C.GDataStreamByteOrder(order))
+}
+
+// GetNewlineType is a wrapper around g_data_input_stream_get_newline_type().
+func (v *DataInputStream) GetNewlineType() DataStreamNewlineType {
+	return DataStreamNewlineType(C.g_data_input_stream_get_newline_type(v.native()))
+}
+
+// SetNewlineType is a wrapper around g_data_input_stream_set_newline_type().
+func (v *DataInputStream) SetNewlineType(newlineType DataStreamNewlineType) {
+	C.g_data_input_stream_set_newline_type(v.native(), C.GDataStreamNewlineType(newlineType))
+}
+
+// dataStreamError converts the error set by the integer reading functions,
+// which do not return a distinct value on failure.
+func dataStreamError(gerr *C.GError) error {
+	if gerr == nil {
+		return nil
+	}
+	return takeError(gerr)
+}
+
+// ReadUint8 is a wrapper around g_data_input_stream_read_byte().
+func (v *DataInputStream) ReadUint8(cancellable *Cancellable) (uint8, error) {
+	var gerr *C.GError
+	c := C.g_data_input_stream_read_byte(v.native(), cancellable.native(), &gerr)
+	return uint8(c), dataStreamError(gerr)
+}
+
+// ReadInt16 is a wrapper around g_data_input_stream_read_int16().
+func (v *DataInputStream) ReadInt16(cancellable *Cancellable) (int16, error) {
+	var gerr *C.GError
+	c := C.g_data_input_stream_read_int16(v.native(), cancellable.native(), &gerr)
+	return int16(c), dataStreamError(gerr)
+}
+
+// ReadUint16 is a wrapper around g_data_input_stream_read_uint16().
+func (v *DataInputStream) ReadUint16(cancellable *Cancellable) (uint16, error) {
+	var gerr *C.GError
+	c := C.g_data_input_stream_read_uint16(v.native(), cancellable.native(), &gerr)
+	return uint16(c), dataStreamError(gerr)
+}
+
+// ReadInt32 is a wrapper around g_data_input_stream_read_int32().
+func (v *DataInputStream) ReadInt32(cancellable *Cancellable) (int32, error) {
+	var gerr *C.GError
+	c := C.g_data_input_stream_read_int32(v.native(), cancellable.native(), &gerr)
+	return int32(c), dataStreamError(gerr)
+}
+
+// ReadUint32 is a wrapper around g_data_input_stream_read_uint32().
+func (v *DataInputStream) ReadUint32(cancellable *Cancellable) (uint32, error) {
+	var gerr *C.GError
+	c := C.g_data_input_stream_read_uint32(v.native(), cancellable.native(), &gerr)
+	return uint32(c), dataStreamError(gerr)
+}
+
+// ReadInt64 is a wrapper around g_data_input_stream_read_int64().
+func (v *DataInputStream) ReadInt64(cancellable *Cancellable) (int64, error) {
+	var gerr *C.GError
+	c := C.g_data_input_stream_read_int64(v.native(), cancellable.native(), &gerr)
+	return int64(c), dataStreamError(gerr)
+}
+
+// ReadUint64 is a wrapper around g_data_input_stream_read_uint64().
+func (v *DataInputStream) ReadUint64(cancellable *Cancellable) (uint64, error) {
+	var gerr *C.GError
+	c := C.g_data_input_stream_read_uint64(v.native(), cancellable.native(), &gerr)
+	return uint64(c), dataStreamError(gerr)
+}
+
+// dataStreamString converts the result of the string reading functions.
+// io.EOF is returned at the end of the stream.
+func dataStreamString(c *C.char, gerr *C.GError) (string, error) {
+	if c == nil {
+		if gerr == nil {
+			return "", io.EOF
+		}
+		return "", takeError(gerr)
+	}
+	defer C.g_free(C.gpointer(c))
+	return C.GoString(c), nil
+}
+
+// ReadLine is a wrapper around g_data_input_stream_read_line_utf8(). The
+// line is returned without its newline, and io.EOF is returned at the end
+// of the stream. Lines which are not valid UTF-8 are an error.
+func (v *DataInputStream) ReadLine(cancellable *Cancellable) (string, error) {
+	var gerr *C.GError
+	c := C.g_data_input_stream_read_line_utf8(v.native(), nil, cancellable.native(), &gerr)
+	return dataStreamString(c, gerr)
+}
+
+// ReadLineAsync is a wrapper around g_data_input_stream_read_line_async().
+func (v *DataInputStream) ReadLineAsync(ioPriority Priority, cancellable *Cancellable, fn AsyncReadyCallback) {
+	C._g_data_input_stream_read_line_async(v.native(), C.int(ioPriority), cancellable.native(),
+		C.gpointer(callback.Assign(fn)))
+}
+
+// ReadLineFinish is a wrapper around
+// g_data_input_stream_read_line_finish_utf8(). It returns the same values as
+// ReadLine.
+func (v *DataInputStream) ReadLineFinish(result *AsyncResult) (string, error) {
+	var gerr *C.GError
+	c := C.g_data_input_stream_read_line_finish_utf8(v.native(), result.native(), nil, &gerr)
+	return dataStreamString(c, gerr)
+}
+
+// ReadUpto is a wrapper around g_data_input_stream_read_upto(). It reads
+// until one of the bytes of stopChars, which is left in the stream, and
+// returns io.EOF at the end of the stream.
+func (v *DataInputStream) ReadUpto(stopChars string, cancellable *Cancellable) (string, error) {
+	cstr := C.CString(stopChars)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var gerr *C.GError
+	c := C.g_data_input_stream_read_upto(v.native(), (*C.gchar)(cstr), C.gssize(len(stopChars)),
+		nil, cancellable.native(), &gerr)
+	return dataStreamString((*C.char)(c), gerr)
+}
+
+/*
+ * GDataOutputStream
+ */
+
+// DataOutputStream is a representation of GIO's GDataOutputStream.
+// Data Output Stream
+type DataOutputStream struct {
+	*FilterOutputStream
+}
+
+// native returns a pointer to the underlying GDataOutputStream.
+func (v *DataOutputStream) native() *C.GDataOutputStream {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGDataOutputStream(p)
+}
+
+// Native returns a pointer to the underlying GDataOutputStream.
+func (v *DataOutputStream) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalDataOutputStream(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapDataOutputStream(obj), nil
+}
+
+func wrapDataOutputStream(obj *Object) *DataOutputStream {
+	return &DataOutputStream{wrapFilterOutputStream(obj)}
+}
+
+// DataOutputStreamNew is a wrapper around g_data_output_stream_new().
+func DataOutputStreamNew(baseStream *OutputStream) *DataOutputStream {
+	c := C.g_data_output_stream_new(baseStream.native())
+	return wrapDataOutputStream(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// GetByteOrder is a wrapper around g_data_output_stream_get_byte_order().
+func (v *DataOutputStream) GetByteOrder() DataStreamByteOrder {
+	return DataStreamByteOrder(C.g_data_output_stream_get_byte_order(v.native()))
+}
+
+// SetByteOrder is a wrapper around g_data_output_stream_set_byte_order().
+func (v *DataOutputStream) SetByteOrder(order DataStreamByteOrder) {
+	C.g_data_output_stream_set_byte_order(v.native(), C.GDataStreamByteOrder(order))
+}
+
+// dataStreamPut converts the result of the put functions.
+func dataStreamPut(ok C.gboolean, gerr *C.GError) error {
+	if !gobool(ok) {
+		return takeError(gerr)
+	}
+	return nil
+}
+
+// PutByte is a wrapper around g_data_output_stream_put_byte().
+func (v *DataOutputStream) PutByte(data byte, cancellable *Cancellable) error {
+	var gerr *C.GError
+	ok := C.g_data_output_stream_put_byte(v.native(), C.guchar(data), cancellable.native(), &gerr)
+	return dataStreamPut(ok, gerr)
+}
+
+// PutInt16 is a wrapper around g_data_output_stream_put_int16().
+func (v *DataOutputStream) PutInt16(data int16, cancellable *Cancellable) error {
+	var gerr *C.GError
+	ok := C.g_data_output_stream_put_int16(v.native(), C.gint16(data), cancellable.native(), &gerr)
+	return dataStreamPut(ok, gerr)
+}
+
+// PutUint16 is a wrapper around g_data_output_stream_put_uint16().
+func (v *DataOutputStream) PutUint16(data uint16, cancellable *Cancellable) error {
+	var gerr *C.GError
+	ok := C.g_data_output_stream_put_uint16(v.native(), C.guint16(data), cancellable.native(), &gerr)
+	return dataStreamPut(ok, gerr)
+}
+
+// PutInt32 is a wrapper around g_data_output_stream_put_int32().
+func (v *DataOutputStream) PutInt32(data int32, cancellable *Cancellable) error {
+	var gerr *C.GError
+	ok := C.g_data_output_stream_put_int32(v.native(), C.gint32(data), cancellable.native(), &gerr)
+	return dataStreamPut(ok, gerr)
+}
+
+// PutUint32 is a wrapper around g_data_output_stream_put_uint32().
+func (v *DataOutputStream) PutUint32(data uint32, cancellable *Cancellable) error {
+	var gerr *C.GError
+	ok := C.g_data_output_stream_put_uint32(v.native(), C.guint32(data), cancellable.native(), &gerr)
+	return dataStreamPut(ok, gerr)
+}
+
+// PutInt64 is a wrapper around g_data_output_stream_put_int64().
+func (v *DataOutputStream) PutInt64(data int64, cancellable *Cancellable) error {
+	var gerr *C.GError
+	ok := C.g_data_output_stream_put_int64(v.native(), C.gint64(data), cancellable.native(), &gerr)
+	return dataStreamPut(ok, gerr)
+}
+
+// PutUint64 is a wrapper around g_data_output_stream_put_uint64().
+func (v *DataOutputStream) PutUint64(data uint64, cancellable *Cancellable) error {
+	var gerr *C.GError
+	ok := C.g_data_output_stream_put_uint64(v.native(), C.guint64(data), cancellable.native(), &gerr)
+	return dataStreamPut(ok, gerr)
+}
+
+// PutString is a wrapper around g_data_output_stream_put_string().
+func (v *DataOutputStream) PutString(str string, cancellable *Cancellable) error {
+	cstr := C.CString(str)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var gerr *C.GError
+	ok := C.g_data_output_stream_put_string(v.native(), cstr, cancellable.native(), &gerr)
+	return dataStreamPut(ok, gerr)
+}
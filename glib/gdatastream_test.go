@@ -0,0 +1,79 @@
+package glib_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func TestDataStreams(t *testing.T) {
+	mem := glib.MemoryOutputStreamNewResizable()
+	out := glib.DataOutputStreamNew(mem.OutputStream)
+	out.SetByteOrder(glib.DATA_STREAM_BYTE_ORDER_LITTLE_ENDIAN)
+
+	if err := out.PutUint32(0xdeadbeef, nil); err != nil {
+		t.Fatal("PutUint32 failed:", err)
+	}
+	if err := out.PutInt16(-2, nil); err != nil {
+		t.Fatal("PutInt16 failed:", err)
+	}
+	if err := out.PutString("first line\nsecond line\r\nlast", nil); err != nil {
+		t.Fatal("PutString failed:", err)
+	}
+	if _, err := out.Close(nil); err != nil {
+		t.Fatal("Close failed:", err)
+	}
+
+	data := mem.GetData()
+	if len(data) != 4+2+28 || data[0] != 0xef {
+		t.Fatalf("Unexpected data % x", data)
+	}
+
+	in := glib.DataInputStreamNew(glib.MemoryInputStreamNewFromBytes(data).InputStream)
+	in.SetByteOrder(glib.DATA_STREAM_BYTE_ORDER_LITTLE_ENDIAN)
+	in.SetNewlineType(glib.DATA_STREAM_NEWLINE_TYPE_ANY)
+
+	if v, err := in.ReadUint32(nil); err != nil || v != 0xdeadbeef {
+		t.Errorf("ReadUint32 returned %x, %v", v, err)
+	}
+	if v, err := in.ReadInt16(nil); err != nil || v != -2 {
+		t.Errorf("ReadInt16 returned %d, %v", v, err)
+	}
+
+	var lines []string
+	for {
+		line, err := in.ReadLine(nil)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal("ReadLine failed:", err)
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) != 3 || lines[0] != "first line" || lines[1] != "second line" || lines[2] != "last" {
+		t.Errorf("Unexpected lines %q", lines)
+	}
+
+	if _, err := in.ReadUint8(nil); err == nil {
+		t.Error("Expected ReadUint8 to fail at the end of the stream")
+	}
+}
+
+func TestMemoryInputStream(t *testing.T) {
+	in := glib.MemoryInputStreamNew()
+	in.AddBytes([]byte("key=value;"))
+	in.AddBytes([]byte("other"))
+
+	data := glib.DataInputStreamNew(in.InputStream)
+	if key, err := data.ReadUpto("=", nil); err != nil || key != "key" {
+		t.Errorf("ReadUpto returned %q, %v", key, err)
+	}
+	if sep, _ := data.ReadUint8(nil); sep != '=' {
+		t.Errorf("Expected the stop character to be left in the stream, got %q", sep)
+	}
+	if value, err := data.ReadUpto(";", nil); err != nil || value != "value" {
+		t.Errorf("ReadUpto returned %q, %v", value, err)
+	}
+}
@@ -0,0 +1,1050 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+// #include "gdbus.go.h"
+import "C"
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/internal/callback"
+)
+
+func init() {
+	tm := []TypeMarshaler{
+		// Enums
+		{Type(C.g_bus_type_get_type()), marshalBusType},
+		{Type(C.g_dbus_call_flags_get_type()), marshalDBusCallFlags},
+		{Type(C.g_dbus_connection_flags_get_type()), marshalDBusConnectionFlags},
+		{Type(C.g_dbus_signal_flags_get_type()), marshalDBusSignalFlags},
+		{Type(C.g_dbus_proxy_flags_get_type()), marshalDBusProxyFlags},
+		{Type(C.g_bus_name_owner_flags_get_type()), marshalBusNameOwnerFlags},
+
+		// Objects/Interfaces
+		{Type(C.g_dbus_connection_get_type()), marshalDBusConnection},
+		{Type(C.g_dbus_proxy_get_type()), marshalDBusProxy},
+		{Type(C.g_dbus_method_invocation_get_type()), marshalDBusMethodInvocation},
+	}
+
+	RegisterGValueMarshalers(tm)
+}
+
+// optionalCString returns a C string for s, or nil if s is empty. The result
+// must be freed with C.free.
+func optionalCString(s string) *C.gchar {
+	if s == "" {
+		return nil
+	}
+	return (*C.gchar)(C.CString(s))
+}
+
+/*
+ * Enums
+ */
+
+// BusType is a representation of GIO's GBusType.
+type BusType int
+
+const (
+	BUS_TYPE_STARTER BusType = C.G_BUS_TYPE_STARTER
+	BUS_TYPE_NONE    BusType = C.G_BUS_TYPE_NONE
+	BUS_TYPE_SYSTEM  BusType = C.G_BUS_TYPE_SYSTEM
+	BUS_TYPE_SESSION BusType = C.G_BUS_TYPE_SESSION
+)
+
+func marshalBusType(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return BusType(c), nil
+}
+
+// DBusCallFlags is a representation of GIO's GDBusCallFlags.
+type DBusCallFlags int
+
+const (
+	DBUS_CALL_FLAGS_NONE                            DBusCallFlags = C.G_DBUS_CALL_FLAGS_NONE
+	DBUS_CALL_FLAGS_NO_AUTO_START                   DBusCallFlags = C.G_DBUS_CALL_FLAGS_NO_AUTO_START
+	DBUS_CALL_FLAGS_ALLOW_INTERACTIVE_AUTHORIZATION DBusCallFlags = C.G_DBUS_CALL_FLAGS_ALLOW_INTERACTIVE_AUTHORIZATION
+)
+
+func marshalDBusCallFlags(p uintptr) (interface{}, error) {
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
+	return DBusCallFlags(c), nil
+}
+
+// DBusConnectionFlags is a representation of GIO's GDBusConnectionFlags.
+type DBusConnectionFlags int
+
+const (
+	DBUS_CONNECTION_FLAGS_NONE                           DBusConnectionFlags = C.G_DBUS_CONNECTION_FLAGS_NONE
+	DBUS_CONNECTION_FLAGS_AUTHENTICATION_CLIENT          DBusConnectionFlags = C.G_DBUS_CONNECTION_FLAGS_AUTHENTICATION_CLIENT
+	DBUS_CONNECTION_FLAGS_AUTHENTICATION_SERVER          DBusConnectionFlags = C.G_DBUS_CONNECTION_FLAGS_AUTHENTICATION_SERVER
+	DBUS_CONNECTION_FLAGS_AUTHENTICATION_ALLOW_ANONYMOUS DBusConnectionFlags = C.G_DBUS_CONNECTION_FLAGS_AUTHENTICATION_ALLOW_ANONYMOUS
+	DBUS_CONNECTION_FLAGS_MESSAGE_BUS_CONNECTION         DBusConnectionFlags = C.G_DBUS_CONNECTION_FLAGS_MESSAGE_BUS_CONNECTION
+	DBUS_CONNECTION_FLAGS_DELAY_MESSAGE_PROCESSING       DBusConnectionFlags = C.G_DBUS_CONNECTION_FLAGS_DELAY_MESSAGE_PROCESSING
+)
+
+func marshalDBusConnectionFlags(p uintptr) (interface{}, error) {
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
+	return DBusConnectionFlags(c), nil
+}
+
+// DBusSignalFlags is a representation of GIO's GDBusSignalFlags.
+type DBusSignalFlags int
+
+const (
+	DBUS_SIGNAL_FLAGS_NONE                 DBusSignalFlags = C.G_DBUS_SIGNAL_FLAGS_NONE
+	DBUS_SIGNAL_FLAGS_NO_MATCH_RULE        DBusSignalFlags = C.G_DBUS_SIGNAL_FLAGS_NO_MATCH_RULE
+	DBUS_SIGNAL_FLAGS_MATCH_ARG0_NAMESPACE DBusSignalFlags = C.G_DBUS_SIGNAL_FLAGS_MATCH_ARG0_NAMESPACE
+	DBUS_SIGNAL_FLAGS_MATCH_ARG0_PATH      DBusSignalFlags = C.G_DBUS_SIGNAL_FLAGS_MATCH_ARG0_PATH
+)
+
+func marshalDBusSignalFlags(p uintptr) (interface{}, error) {
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
+	return DBusSignalFlags(c), nil
+}
+
+// DBusProxyFlags is a representation of GIO's GDBusProxyFlags.
+type DBusProxyFlags int
+
+const (
+	DBUS_PROXY_FLAGS_NONE                       DBusProxyFlags = C.G_DBUS_PROXY_FLAGS_NONE
+	DBUS_PROXY_FLAGS_DO_NOT_LOAD_PROPERTIES     DBusProxyFlags = C.G_DBUS_PROXY_FLAGS_DO_NOT_LOAD_PROPERTIES
+	DBUS_PROXY_FLAGS_DO_NOT_CONNECT_SIGNALS     DBusProxyFlags = C.G_DBUS_PROXY_FLAGS_DO_NOT_CONNECT_SIGNALS
+	DBUS_PROXY_FLAGS_DO_NOT_AUTO_START          DBusProxyFlags = C.G_DBUS_PROXY_FLAGS_DO_NOT_AUTO_START
+	DBUS_PROXY_FLAGS_GET_INVALIDATED_PROPERTIES DBusProxyFlags = C.G_DBUS_PROXY_FLAGS_GET_INVALIDATED_PROPERTIES
+)
+
+func marshalDBusProxyFlags(p uintptr) (interface{}, error) {
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
+	return DBusProxyFlags(c), nil
+}
+
+// BusNameOwnerFlags is a representation of GIO's GBusNameOwnerFlags.
+type BusNameOwnerFlags int
+
+const (
+	BUS_NAME_OWNER_FLAGS_NONE              BusNameOwnerFlags = C.G_BUS_NAME_OWNER_FLAGS_NONE
+	BUS_NAME_OWNER_FLAGS_ALLOW_REPLACEMENT BusNameOwnerFlags = C.G_BUS_NAME_OWNER_FLAGS_ALLOW_REPLACEMENT
+	BUS_NAME_OWNER_FLAGS_REPLACE           BusNameOwnerFlags = C.G_BUS_NAME_OWNER_FLAGS_REPLACE
+)
+
+func marshalBusNameOwnerFlags(p uintptr) (interface{}, error) {
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
+	return BusNameOwnerFlags(c), nil
+}
+
+/*
+ * GDBusConnection
+ */
+
+// DBusConnection is a representation of GIO's GDBusConnection.
+type DBusConnection struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GDBusConnection.
+func (v *DBusConnection) native() *C.GDBusConnection {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	return C.toGDBusConnection(unsafe.Pointer(v.GObject))
+}
+
+// Native returns a pointer to the underlying GDBusConnection.
+func (v *DBusConnection) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalDBusConnection(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	if c == nil {
+		return (*DBusConnection)(nil), nil
+	}
+	return wrapDBusConnection(Take(unsafe.Pointer(c))), nil
+}
+
+func wrapDBusConnection(obj *Object) *DBusConnection {
+	if obj == nil {
+		return nil
+	}
+	return &DBusConnection{obj}
+}
+
+// BusGetSync is a wrapper around g_bus_get_sync().
+func BusGetSync(busType BusType, cancellable *Cancellable) (*DBusConnection, error) {
+	var err *C.GError
+	c := C.g_bus_get_sync(C.GBusType(busType), cancellable.native(), &err)
+	if c == nil {
+		return nil, takeError(err)
+	}
+	return wrapDBusConnection(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// BusGet is a wrapper around g_bus_get().
+func BusGet(busType BusType, cancellable *Cancellable, fn AsyncReadyCallback) {
+	C._g_bus_get(C.GBusType(busType), cancellable.native(), C.gpointer(callback.Assign(fn)))
+}
+
+// BusGetFinish is a wrapper around g_bus_get_finish().
+func BusGetFinish(result *AsyncResult) (*DBusConnection, error) {
+	var err *C.GError
+	c := C.g_bus_get_finish(result.native(), &err)
+	if c == nil {
+		return nil, takeError(err)
+	}
+	return wrapDBusConnection(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// DBusConnectionNewForAddressSync is a wrapper around
+// g_dbus_connection_new_for_address_sync(). Use
+// DBUS_CONNECTION_FLAGS_AUTHENTICATION_CLIENT and
+// DBUS_CONNECTION_FLAGS_MESSAGE_BUS_CONNECTION to connect to the address of a
+// message bus, such as a private dbus-daemon.
+func DBusConnectionNewForAddressSync(address string, flags DBusConnectionFlags, cancellable *Cancellable) (*DBusConnection, error) {
+	cstr := (*C.gchar)(C.CString(address))
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_dbus_connection_new_for_address_sync(cstr, C.GDBusConnectionFlags(flags), nil, cancellable.native(), &err)
+	if c == nil {
+		return nil, takeError(err)
+	}
+	return wrapDBusConnection(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// GetUniqueName is a wrapper around g_dbus_connection_get_unique_name().
+func (v *DBusConnection) GetUniqueName() string {
+	return goString(C.g_dbus_connection_get_unique_name(v.native()))
+}
+
+// IsClosed is a wrapper around g_dbus_connection_is_closed().
+func (v *DBusConnection) IsClosed() bool {
+	return gobool(C.g_dbus_connection_is_closed(v.native()))
+}
+
+// Close is a wrapper around g_dbus_connection_close_sync().
+func (v *DBusConnection) Close(cancellable *Cancellable) error {
+	var err *C.GError
+	c := C.g_dbus_connection_close_sync(v.native(), cancellable.native(), &err)
+	if !gobool(c) {
+		return takeError(err)
+	}
+	return nil
+}
+
+// Flush is a wrapper around g_dbus_connection_flush_sync().
+func (v *DBusConnection) Flush(cancellable *Cancellable) error {
+	var err *C.GError
+	c := C.g_dbus_connection_flush_sync(v.native(), cancellable.native(), &err)
+	if !gobool(c) {
+		return takeError(err)
+	}
+	return nil
+}
+
+// CallSync is a wrapper around g_dbus_connection_call_sync(). parameters is
+// a tuple holding the arguments, or nil if there are none, and replyType may
+// be nil. timeoutMsec is -1 for the default timeout.
+func (v *DBusConnection) CallSync(busName, objectPath, interfaceName, methodName string,
+	parameters *Variant, replyType *VariantType, flags DBusCallFlags, timeoutMsec int,
+	cancellable *Cancellable) (*Variant, error) {
+
+	cstr1 := optionalCString(busName)
+	defer C.free(unsafe.Pointer(cstr1))
+	cstr2 := (*C.gchar)(C.CString(objectPath))
+	defer C.free(unsafe.Pointer(cstr2))
+	cstr3 := (*C.gchar)(C.CString(interfaceName))
+	defer C.free(unsafe.Pointer(cstr3))
+	cstr4 := (*C.gchar)(C.CString(methodName))
+	defer C.free(unsafe.Pointer(cstr4))
+
+	var err *C.GError
+	c := C.g_dbus_connection_call_sync(v.native(), cstr1, cstr2, cstr3, cstr4,
+		parameters.native(), replyType.native(), C.GDBusCallFlags(flags),
+		C.gint(timeoutMsec), cancellable.native(), &err)
+	if c == nil {
+		return nil, takeError(err)
+	}
+	return assumeVariant(c), nil
+}
+
+// Call is a wrapper around g_dbus_connection_call().
+func (v *DBusConnection) Call(busName, objectPath, interfaceName, methodName string,
+	parameters *Variant, replyType *VariantType, flags DBusCallFlags, timeoutMsec int,
+	cancellable *Cancellable, fn AsyncReadyCallback) {
+
+	cstr1 := optionalCString(busName)
+	defer C.free(unsafe.Pointer(cstr1))
+	cstr2 := (*C.gchar)(C.CString(objectPath))
+	defer C.free(unsafe.Pointer(cstr2))
+	cstr3 := (*C.gchar)(C.CString(interfaceName))
+	defer C.free(unsafe.Pointer(cstr3))
+	cstr4 := (*C.gchar)(C.CString(methodName))
+	defer C.free(unsafe.Pointer(cstr4))
+
+	C._g_dbus_connection_call(v.native(), cstr1, cstr2, cstr3, cstr4,
+		parameters.native(), replyType.native(), C.GDBusCallFlags(flags),
+		C.gint(timeoutMsec), cancellable.native(), C.gpointer(callback.Assign(fn)))
+}
+
+// CallFinish is a wrapper around g_dbus_connection_call_finish().
+func (v *DBusConnection) CallFinish(result *AsyncResult) (*Variant, error) {
+	var err *C.GError
+	c := C.g_dbus_connection_call_finish(v.native(), result.native(), &err)
+	if c == nil {
+		return nil, takeError(err)
+	}
+	return assumeVariant(c), nil
+}
+
+// EmitSignal is a wrapper around g_dbus_connection_emit_signal().
+// destinationBusName may be empty to broadcast the signal, and parameters is
+// a tuple or nil.
+func (v *DBusConnection) EmitSignal(destinationBusName, objectPath, interfaceName, signalName string, parameters *Variant) error {
+	cstr1 := optionalCString(destinationBusName)
+	defer C.free(unsafe.Pointer(cstr1))
+	cstr2 := (*C.gchar)(C.CString(objectPath))
+	defer C.free(unsafe.Pointer(cstr2))
+	cstr3 := (*C.gchar)(C.CString(interfaceName))
+	defer C.free(unsafe.Pointer(cstr3))
+	cstr4 := (*C.gchar)(C.CString(signalName))
+	defer C.free(unsafe.Pointer(cstr4))
+
+	var err *C.GError
+	c := C.g_dbus_connection_emit_signal(v.native(), cstr1, cstr2, cstr3, cstr4, parameters.native(), &err)
+	if !gobool(c) {
+		return takeError(err)
+	}
+	return nil
+}
+
+// DBusSignalCallback is the callback of SignalSubscribe.
+type DBusSignalCallback func(connection *DBusConnection, senderName, objectPath, interfaceName, signalName string, parameters *Variant)
+
+// SignalSubscribe is a wrapper around g_dbus_connection_signal_subscribe().
+// Empty strings match any sender, interface, member, object path or first
+// argument. The callback is run in the thread-default main context of the
+// caller.
+func (v *DBusConnection) SignalSubscribe(sender, interfaceName, member, objectPath, arg0 string,
+	flags DBusSignalFlags, f DBusSignalCallback) uint {
+
+	cstr1 := optionalCString(sender)
+	defer C.free(unsafe.Pointer(cstr1))
+	cstr2 := optionalCString(interfaceName)
+	defer C.free(unsafe.Pointer(cstr2))
+	cstr3 := optionalCString(member)
+	defer C.free(unsafe.Pointer(cstr3))
+	cstr4 := optionalCString(objectPath)
+	defer C.free(unsafe.Pointer(cstr4))
+	cstr5 := optionalCString(arg0)
+	defer C.free(unsafe.Pointer(cstr5))
+
+	c := C._g_dbus_connection_signal_subscribe(v.native(), cstr1, cstr2, cstr3, cstr4, cstr5,
+		C.GDBusSignalFlags(flags), C.gpointer(callback.Assign(f)))
+	return uint(c)
+}
+
+// SignalUnsubscribe is a wrapper around g_dbus_connection_signal_unsubscribe().
+func (v *DBusConnection) SignalUnsubscribe(subscriptionID uint) {
+	C.g_dbus_connection_signal_unsubscribe(v.native(), C.guint(subscriptionID))
+}
+
+//export goDBusSignalCallback
+func goDBusSignalCallback(connection *C.GDBusConnection, senderName, objectPath, interfaceName, signalName *C.gchar,
+	parameters *C.GVariant, userData C.gpointer) {
+
+	fn := callback.Get(uintptr(userData)).(DBusSignalCallback)
+	fn(wrapDBusConnection(Take(unsafe.Pointer(connection))), goString(senderName), goString(objectPath),
+		goString(interfaceName), goString(signalName), takeVariant(parameters))
+}
+
+/*
+ * Exported objects
+ */
+
+// DBusInterfaceMethodCallFunc handles a method call on an exported object.
+// parameters is a tuple holding the arguments of the call. The function must
+// complete invocation exactly once, possibly later and from another
+// goroutine, by calling one of its Return methods.
+type DBusInterfaceMethodCallFunc func(connection *DBusConnection, sender, objectPath, interfaceName, methodName string,
+	parameters *Variant, invocation *DBusMethodInvocation)
+
+// DBusInterfaceGetPropertyFunc handles reading a property of an exported
+// object.
+type DBusInterfaceGetPropertyFunc func(connection *DBusConnection, sender, objectPath, interfaceName, propertyName string) (*Variant, error)
+
+// DBusInterfaceSetPropertyFunc handles writing a property of an exported
+// object.
+type DBusInterfaceSetPropertyFunc func(connection *DBusConnection, sender, objectPath, interfaceName, propertyName string, value *Variant) error
+
+// DBusInterfaceVTable is a representation of GIO's GDBusInterfaceVTable. Any
+// of its functions may be nil, in which case the corresponding calls fail.
+type DBusInterfaceVTable struct {
+	MethodCall  DBusInterfaceMethodCallFunc
+	GetProperty DBusInterfaceGetPropertyFunc
+	SetProperty DBusInterfaceSetPropertyFunc
+}
+
+// RegisterObject is a wrapper around g_dbus_connection_register_object().
+// Method calls and property accesses are handled by vtable in the
+// thread-default main context of the caller.
+func (v *DBusConnection) RegisterObject(objectPath string, interfaceInfo *DBusInterfaceInfo, vtable *DBusInterfaceVTable) (uint, error) {
+	cstr := (*C.gchar)(C.CString(objectPath))
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C._g_dbus_connection_register_object(v.native(), cstr, interfaceInfo.native(),
+		C.gpointer(callback.Assign(vtable)), &err)
+	if c == 0 {
+		return 0, takeError(err)
+	}
+	return uint(c), nil
+}
+
+// UnregisterObject is a wrapper around g_dbus_connection_unregister_object().
+func (v *DBusConnection) UnregisterObject(registrationID uint) bool {
+	return gobool(C.g_dbus_connection_unregister_object(v.native(), C.guint(registrationID)))
+}
+
+// ExportObject exports obj at objectPath, implementing the interface
+// described by interfaceInfo, usually taken from a DBusNodeInfo.
+//
+// Method calls are dispatched to the method of obj with the same name. The
+// arguments of the call are decoded into the parameters of the method as by
+// Variant.Decode, and its results are converted to the out arguments of the
+// D-Bus method as by VariantFromGo. If the last result of the method is a
+// non-nil error, the call fails with org.freedesktop.DBus.Error.Failed.
+//
+// Properties are read with a method named Get followed by the property name,
+// taking no argument and returning the value and optionally an error, and
+// written with a method named Set followed by the property name, taking the
+// new value and optionally returning an error.
+func (v *DBusConnection) ExportObject(objectPath string, interfaceInfo *DBusInterfaceInfo, obj interface{}) (uint, error) {
+	rv := reflect.ValueOf(obj)
+
+	vtable := &DBusInterfaceVTable{
+		MethodCall: func(_ *DBusConnection, _, _, _, methodName string, parameters *Variant, invocation *DBusMethodInvocation) {
+			out, err := exportedMethodCall(rv, interfaceInfo, methodName, parameters)
+			if err != nil {
+				invocation.ReturnDBusError("org.freedesktop.DBus.Error.Failed", err.Error())
+				return
+			}
+			invocation.ReturnValue(out)
+		},
+		GetProperty: func(_ *DBusConnection, _, _, _, propertyName string) (*Variant, error) {
+			sig, err := interfaceInfo.propertySignature(propertyName)
+			if err != nil {
+				return nil, err
+			}
+			results, err := callExported(rv, "Get"+propertyName, nil)
+			if err != nil {
+				return nil, err
+			}
+			if len(results) != 1 {
+				return nil, fmt.Errorf("Get%s must return exactly one value", propertyName)
+			}
+			return variantFromValue(results[0], sig)
+		},
+		SetProperty: func(_ *DBusConnection, _, _, _, propertyName string, value *Variant) error {
+			if _, err := interfaceInfo.propertySignature(propertyName); err != nil {
+				return err
+			}
+			_, err := callExported(rv, "Set"+propertyName, VariantNewTuple(value))
+			return err
+		},
+	}
+
+	return v.RegisterObject(objectPath, interfaceInfo, vtable)
+}
+
+// exportedMethodCall calls the method of an exported object handling
+// methodName and returns the tuple of its results.
+func exportedMethodCall(rv reflect.Value, interfaceInfo *DBusInterfaceInfo, methodName string, parameters *Variant) (*Variant, error) {
+	cstr := (*C.gchar)(C.CString(methodName))
+	defer C.free(unsafe.Pointer(cstr))
+
+	method := C.g_dbus_interface_info_lookup_method(interfaceInfo.native(), cstr)
+	if method == nil {
+		return nil, fmt.Errorf("no method %s in interface %s", methodName, interfaceInfo.GetName())
+	}
+
+	results, err := callExported(rv, methodName, parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]IVariant, len(results))
+	for i, result := range results {
+		sig := C._g_dbus_method_info_get_out_signature(method, C.gint(i))
+		if sig == nil {
+			return nil, fmt.Errorf("%s returned %d values, more than its out arguments", methodName, len(results))
+		}
+		if out[i], err = variantFromValue(result, goString(sig)); err != nil {
+			return nil, err
+		}
+	}
+	if sig := C._g_dbus_method_info_get_out_signature(method, C.gint(len(results))); sig != nil {
+		return nil, fmt.Errorf("%s returned %d values, fewer than its out arguments", methodName, len(results))
+	}
+	return VariantNewTuple(out...), nil
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// callExported calls the method name of rv with the children of the tuple
+// parameters as arguments, and returns its results without the trailing
+// error.
+func callExported(rv reflect.Value, name string, parameters *Variant) ([]reflect.Value, error) {
+	method := rv.MethodByName(name)
+	if !method.IsValid() {
+		return nil, fmt.Errorf("%s is not implemented", name)
+	}
+
+	t := method.Type()
+	var n uint
+	if parameters != nil {
+		n = parameters.NChildren()
+	}
+	if t.IsVariadic() || uint(t.NumIn()) != n {
+		return nil, fmt.Errorf("%s takes %d arguments, got %d", name, t.NumIn(), n)
+	}
+
+	args := make([]reflect.Value, n)
+	for i := range args {
+		arg := reflect.New(t.In(i)).Elem()
+		if err := parameters.GetChildValue(uint(i)).decodeInto(arg); err != nil {
+			return nil, fmt.Errorf("argument %d of %s: %v", i, name, err)
+		}
+		args[i] = arg
+	}
+
+	results := method.Call(args)
+	if t.NumOut() > 0 && t.Out(t.NumOut()-1) == errorType {
+		last := results[len(results)-1]
+		results = results[:len(results)-1]
+		if !last.IsNil() {
+			return nil, last.Interface().(error)
+		}
+	}
+	return results, nil
+}
+
+func dbusInterfaceVTable(id C.gpointer) *DBusInterfaceVTable {
+	return callback.Get(uintptr(id)).(*DBusInterfaceVTable)
+}
+
+//export goDBusMethodCall
+func goDBusMethodCall(connection *C.GDBusConnection, sender, objectPath, interfaceName, methodName *C.gchar,
+	parameters *C.GVariant, invocation *C.GDBusMethodInvocation, userData C.gpointer) {
+
+	// The handler owns the invocation.
+	inv := wrapDBusMethodInvocation(AssumeOwnership(unsafe.Pointer(invocation)))
+
+	fn := dbusInterfaceVTable(userData).MethodCall
+	if fn == nil {
+		inv.ReturnDBusError("org.freedesktop.DBus.Error.UnknownMethod",
+			fmt.Sprintf("Method %s is not implemented", goString(methodName)))
+		return
+	}
+	fn(wrapDBusConnection(Take(unsafe.Pointer(connection))), goString(sender), goString(objectPath),
+		goString(interfaceName), goString(methodName), takeVariant(parameters), inv)
+}
+
+//export goDBusGetProperty
+func goDBusGetProperty(connection *C.GDBusConnection, sender, objectPath, interfaceName, propertyName *C.gchar,
+	errorMessage **C.char, userData C.gpointer) *C.GVariant {
+
+	fn := dbusInterfaceVTable(userData).GetProperty
+	if fn == nil {
+		*errorMessage = C.CString(fmt.Sprintf("Property %s is not readable", goString(propertyName)))
+		return nil
+	}
+
+	value, err := fn(wrapDBusConnection(Take(unsafe.Pointer(connection))), goString(sender), goString(objectPath),
+		goString(interfaceName), goString(propertyName))
+	if err != nil {
+		*errorMessage = C.CString(err.Error())
+		return nil
+	}
+	if value == nil {
+		*errorMessage = C.CString(fmt.Sprintf("Property %s has no value", goString(propertyName)))
+		return nil
+	}
+	// The caller consumes a reference, while the Go one is released by the
+	// finalizer of value.
+	return C.g_variant_ref(value.native())
+}
+
+//export goDBusSetProperty
+func goDBusSetProperty(connection *C.GDBusConnection, sender, objectPath, interfaceName, propertyName *C.gchar,
+	value *C.GVariant, errorMessage **C.char, userData C.gpointer) C.gboolean {
+
+	fn := dbusInterfaceVTable(userData).SetProperty
+	if fn == nil {
+		*errorMessage = C.CString(fmt.Sprintf("Property %s is not writable", goString(propertyName)))
+		return gbool(false)
+	}
+
+	err := fn(wrapDBusConnection(Take(unsafe.Pointer(connection))), goString(sender), goString(objectPath),
+		goString(interfaceName), goString(propertyName), takeVariant(value))
+	if err != nil {
+		*errorMessage = C.CString(err.Error())
+		return gbool(false)
+	}
+	return gbool(true)
+}
+
+/*
+ * GDBusMethodInvocation
+ */
+
+// DBusMethodInvocation is a representation of GIO's GDBusMethodInvocation.
+type DBusMethodInvocation struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GDBusMethodInvocation.
+func (v *DBusMethodInvocation) native() *C.GDBusMethodInvocation {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	return C.toGDBusMethodInvocation(unsafe.Pointer(v.GObject))
+}
+
+// Native returns a pointer to the underlying GDBusMethodInvocation.
+func (v *DBusMethodInvocation) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalDBusMethodInvocation(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	return wrapDBusMethodInvocation(Take(unsafe.Pointer(c))), nil
+}
+
+func wrapDBusMethodInvocation(obj *Object) *DBusMethodInvocation {
+	if obj == nil {
+		return nil
+	}
+	return &DBusMethodInvocation{obj}
+}
+
+// GetSender is a wrapper around g_dbus_method_invocation_get_sender().
+func (v *DBusMethodInvocation) GetSender() string {
+	return goString(C.g_dbus_method_invocation_get_sender(v.native()))
+}
+
+// GetObjectPath is a wrapper around g_dbus_method_invocation_get_object_path().
+func (v *DBusMethodInvocation) GetObjectPath() string {
+	return goString(C.g_dbus_method_invocation_get_object_path(v.native()))
+}
+
+// GetInterfaceName is a wrapper around
+// g_dbus_method_invocation_get_interface_name().
+func (v *DBusMethodInvocation) GetInterfaceName() string {
+	return goString(C.g_dbus_method_invocation_get_interface_name(v.native()))
+}
+
+// GetMethodName is a wrapper around g_dbus_method_invocation_get_method_name().
+func (v *DBusMethodInvocation) GetMethodName() string {
+	return goString(C.g_dbus_method_invocation_get_method_name(v.native()))
+}
+
+// GetConnection is a wrapper around g_dbus_method_invocation_get_connection().
+func (v *DBusMethodInvocation) GetConnection() *DBusConnection {
+	c := C.g_dbus_method_invocation_get_connection(v.native())
+	return wrapDBusConnection(Take(unsafe.Pointer(c)))
+}
+
+// GetParameters is a wrapper around g_dbus_method_invocation_get_parameters().
+func (v *DBusMethodInvocation) GetParameters() *Variant {
+	return takeVariant(C.g_dbus_method_invocation_get_parameters(v.native()))
+}
+
+// ReturnValue is a wrapper around g_dbus_method_invocation_return_value().
+// parameters is a tuple holding the out arguments, or nil if there are none.
+func (v *DBusMethodInvocation) ReturnValue(parameters *Variant) {
+	// The invocation is consumed, while the Go reference is released by the
+	// finalizer of v.
+	C.g_object_ref(C.gpointer(v.native()))
+	C.g_dbus_method_invocation_return_value(v.native(), parameters.native())
+}
+
+// ReturnDBusError is a wrapper around
+// g_dbus_method_invocation_return_dbus_error().
+func (v *DBusMethodInvocation) ReturnDBusError(errorName, errorMessage string) {
+	cstr1 := (*C.gchar)(C.CString(errorName))
+	defer C.free(unsafe.Pointer(cstr1))
+	cstr2 := (*C.gchar)(C.CString(errorMessage))
+	defer C.free(unsafe.Pointer(cstr2))
+
+	C.g_object_ref(C.gpointer(v.native()))
+	C.g_dbus_method_invocation_return_dbus_error(v.native(), cstr1, cstr2)
+}
+
+/*
+ * GDBusNodeInfo
+ */
+
+// DBusNodeInfo is a representation of GIO's GDBusNodeInfo, the parsed form
+// of D-Bus introspection XML.
+type DBusNodeInfo struct {
+	info *C.GDBusNodeInfo
+}
+
+// native returns a pointer to the underlying GDBusNodeInfo.
+func (v *DBusNodeInfo) native() *C.GDBusNodeInfo {
+	if v == nil {
+		return nil
+	}
+	return v.info
+}
+
+// Native returns a pointer to the underlying GDBusNodeInfo.
+func (v *DBusNodeInfo) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+// DBusNodeInfoNewForXML is a wrapper around g_dbus_node_info_new_for_xml().
+func DBusNodeInfoNewForXML(xmlData string) (*DBusNodeInfo, error) {
+	cstr := (*C.gchar)(C.CString(xmlData))
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_dbus_node_info_new_for_xml(cstr, &err)
+	if c == nil {
+		return nil, takeError(err)
+	}
+
+	info := &DBusNodeInfo{c}
+	runtime.SetFinalizer(info, func(v *DBusNodeInfo) { FinalizerStrategy(v.unref) })
+	return info, nil
+}
+
+func (v *DBusNodeInfo) unref() {
+	C.g_dbus_node_info_unref(v.native())
+}
+
+// GetPath returns the object path of the node, if any.
+func (v *DBusNodeInfo) GetPath() string {
+	return goString(v.native().path)
+}
+
+// GetInterfaces returns the interfaces of the node.
+func (v *DBusNodeInfo) GetInterfaces() []*DBusInterfaceInfo {
+	var infos []*DBusInterfaceInfo
+	for i := 0; ; i++ {
+		c := C._g_dbus_node_info_get_interface(v.native(), C.gint(i))
+		if c == nil {
+			break
+		}
+		infos = append(infos, takeDBusInterfaceInfo(c))
+	}
+	return infos
+}
+
+// LookupInterface is a wrapper around g_dbus_node_info_lookup_interface().
+func (v *DBusNodeInfo) LookupInterface(name string) *DBusInterfaceInfo {
+	cstr := (*C.gchar)(C.CString(name))
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_dbus_node_info_lookup_interface(v.native(), cstr)
+	if c == nil {
+		return nil
+	}
+	return takeDBusInterfaceInfo(c)
+}
+
+/*
+ * GDBusInterfaceInfo
+ */
+
+// DBusInterfaceInfo is a representation of GIO's GDBusInterfaceInfo.
+type DBusInterfaceInfo struct {
+	info *C.GDBusInterfaceInfo
+}
+
+// native returns a pointer to the underlying GDBusInterfaceInfo.
+func (v *DBusInterfaceInfo) native() *C.GDBusInterfaceInfo {
+	if v == nil {
+		return nil
+	}
+	return v.info
+}
+
+// Native returns a pointer to the underlying GDBusInterfaceInfo.
+func (v *DBusInterfaceInfo) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+// takeDBusInterfaceInfo wraps a GDBusInterfaceInfo owned by someone else,
+// taking a reference to it.
+func takeDBusInterfaceInfo(c *C.GDBusInterfaceInfo) *DBusInterfaceInfo {
+	info := &DBusInterfaceInfo{C.g_dbus_interface_info_ref(c)}
+	runtime.SetFinalizer(info, func(v *DBusInterfaceInfo) { FinalizerStrategy(v.unref) })
+	return info
+}
+
+func (v *DBusInterfaceInfo) unref() {
+	C.g_dbus_interface_info_unref(v.native())
+}
+
+// GetName returns the name of the interface.
+func (v *DBusInterfaceInfo) GetName() string {
+	return goString(v.native().name)
+}
+
+// propertySignature returns the type of the property name of the interface.
+func (v *DBusInterfaceInfo) propertySignature(name string) (string, error) {
+	cstr := (*C.gchar)(C.CString(name))
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_dbus_interface_info_lookup_property(v.native(), cstr)
+	if c == nil {
+		return "", fmt.Errorf("no property %s in interface %s", name, v.GetName())
+	}
+	return goString(c.signature), nil
+}
+
+/*
+ * Bus names
+ */
+
+// BusNameCallback is the callback of BusOwnName and BusOwnNameOnConnection.
+// connection is nil when the connection to the bus could not be made.
+type BusNameCallback func(connection *DBusConnection, name string)
+
+// busNameClosure returns a closure for f, or nil if f is nil.
+func busNameClosure(f BusNameCallback) *C.GClosure {
+	if f == nil {
+		return nil
+	}
+	return ClosureNew(f)
+}
+
+// BusOwnName is a wrapper around g_bus_own_name_with_closures(). Any of the
+// callbacks may be nil.
+func BusOwnName(busType BusType, name string, flags BusNameOwnerFlags,
+	busAcquired, nameAcquired, nameLost BusNameCallback) uint {
+
+	cstr := (*C.gchar)(C.CString(name))
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_bus_own_name_with_closures(C.GBusType(busType), cstr, C.GBusNameOwnerFlags(flags),
+		busNameClosure(busAcquired), busNameClosure(nameAcquired), busNameClosure(nameLost))
+	return uint(c)
+}
+
+// BusOwnNameOnConnection is a wrapper around
+// g_bus_own_name_on_connection_with_closures(). Any of the callbacks may be
+// nil.
+func BusOwnNameOnConnection(connection *DBusConnection, name string, flags BusNameOwnerFlags,
+	nameAcquired, nameLost BusNameCallback) uint {
+
+	cstr := (*C.gchar)(C.CString(name))
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_bus_own_name_on_connection_with_closures(connection.native(), cstr, C.GBusNameOwnerFlags(flags),
+		busNameClosure(nameAcquired), busNameClosure(nameLost))
+	return uint(c)
+}
+
+// BusUnownName is a wrapper around g_bus_unown_name().
+func BusUnownName(ownerID uint) {
+	C.g_bus_unown_name(C.guint(ownerID))
+}
+
+/*
+ * GDBusProxy
+ */
+
+// DBusProxy is a representation of GIO's GDBusProxy. Signals emitted by the
+// remote object are received through its "g-signal" signal, whose handlers
+// take the proxy, the sender name, the signal name and the parameters
+// Variant.
+type DBusProxy struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GDBusProxy.
+func (v *DBusProxy) native() *C.GDBusProxy {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	return C.toGDBusProxy(unsafe.Pointer(v.GObject))
+}
+
+// Native returns a pointer to the underlying GDBusProxy.
+func (v *DBusProxy) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalDBusProxy(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	return wrapDBusProxy(Take(unsafe.Pointer(c))), nil
+}
+
+func wrapDBusProxy(obj *Object) *DBusProxy {
+	if obj == nil {
+		return nil
+	}
+	return &DBusProxy{obj}
+}
+
+// DBusProxyNewSync is a wrapper around g_dbus_proxy_new_sync(). info may be
+// nil, and name may be empty if connection is not a message bus connection.
+func DBusProxyNewSync(connection *DBusConnection, flags DBusProxyFlags, info *DBusInterfaceInfo,
+	name, objectPath, interfaceName string, cancellable *Cancellable) (*DBusProxy, error) {
+
+	cstr1 := optionalCString(name)
+	defer C.free(unsafe.Pointer(cstr1))
+	cstr2 := (*C.gchar)(C.CString(objectPath))
+	defer C.free(unsafe.Pointer(cstr2))
+	cstr3 := (*C.gchar)(C.CString(interfaceName))
+	defer C.free(unsafe.Pointer(cstr3))
+
+	var err *C.GError
+	c := C.g_dbus_proxy_new_sync(connection.native(), C.GDBusProxyFlags(flags), info.native(),
+		cstr1, cstr2, cstr3, cancellable.native(), &err)
+	if c == nil {
+		return nil, takeError(err)
+	}
+	return wrapDBusProxy(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// DBusProxyNewForBusSync is a wrapper around g_dbus_proxy_new_for_bus_sync().
+// info may be nil.
+func DBusProxyNewForBusSync(busType BusType, flags DBusProxyFlags, info *DBusInterfaceInfo,
+	name, objectPath, interfaceName string, cancellable *Cancellable) (*DBusProxy, error) {
+
+	cstr1 := (*C.gchar)(C.CString(name))
+	defer C.free(unsafe.Pointer(cstr1))
+	cstr2 := (*C.gchar)(C.CString(objectPath))
+	defer C.free(unsafe.Pointer(cstr2))
+	cstr3 := (*C.gchar)(C.CString(interfaceName))
+	defer C.free(unsafe.Pointer(cstr3))
+
+	var err *C.GError
+	c := C.g_dbus_proxy_new_for_bus_sync(C.GBusType(busType), C.GDBusProxyFlags(flags), info.native(),
+		cstr1, cstr2, cstr3, cancellable.native(), &err)
+	if c == nil {
+		return nil, takeError(err)
+	}
+	return wrapDBusProxy(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// GetConnection is a wrapper around g_dbus_proxy_get_connection().
+func (v *DBusProxy) GetConnection() *DBusConnection {
+	c := C.g_dbus_proxy_get_connection(v.native())
+	return wrapDBusConnection(Take(unsafe.Pointer(c)))
+}
+
+// GetName is a wrapper around g_dbus_proxy_get_name().
+func (v *DBusProxy) GetName() string {
+	return goString(C.g_dbus_proxy_get_name(v.native()))
+}
+
+// GetNameOwner is a wrapper around g_dbus_proxy_get_name_owner().
+func (v *DBusProxy) GetNameOwner() string {
+	c := C.g_dbus_proxy_get_name_owner(v.native())
+	defer C.g_free(C.gpointer(c))
+	return goString(c)
+}
+
+// GetObjectPath is a wrapper around g_dbus_proxy_get_object_path().
+func (v *DBusProxy) GetObjectPath() string {
+	return goString(C.g_dbus_proxy_get_object_path(v.native()))
+}
+
+// GetInterfaceName is a wrapper around g_dbus_proxy_get_interface_name().
+func (v *DBusProxy) GetInterfaceName() string {
+	return goString(C.g_dbus_proxy_get_interface_name(v.native()))
+}
+
+// GetDefaultTimeout is a wrapper around g_dbus_proxy_get_default_timeout().
+func (v *DBusProxy) GetDefaultTimeout() int {
+	return int(C.g_dbus_proxy_get_default_timeout(v.native()))
+}
+
+// SetDefaultTimeout is a wrapper around g_dbus_proxy_set_default_timeout().
+func (v *DBusProxy) SetDefaultTimeout(timeoutMsec int) {
+	C.g_dbus_proxy_set_default_timeout(v.native(), C.gint(timeoutMsec))
+}
+
+// GetCachedProperty is a wrapper around g_dbus_proxy_get_cached_property().
+// It returns nil if the property is not cached.
+func (v *DBusProxy) GetCachedProperty(propertyName string) *Variant {
+	cstr := (*C.gchar)(C.CString(propertyName))
+	defer C.free(unsafe.Pointer(cstr))
+
+	return assumeVariant(C.g_dbus_proxy_get_cached_property(v.native(), cstr))
+}
+
+// SetCachedProperty is a wrapper around g_dbus_proxy_set_cached_property().
+// A nil value removes the property from the cache.
+func (v *DBusProxy) SetCachedProperty(propertyName string, value *Variant) {
+	cstr := (*C.gchar)(C.CString(propertyName))
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_dbus_proxy_set_cached_property(v.native(), cstr, value.native())
+}
+
+// GetCachedPropertyNames is a wrapper around
+// g_dbus_proxy_get_cached_property_names().
+func (v *DBusProxy) GetCachedPropertyNames() []string {
+	c := C.g_dbus_proxy_get_cached_property_names(v.native())
+	if c == nil {
+		return nil
+	}
+	defer C.g_strfreev(c)
+
+	var names []string
+	for p := c; *p != nil; p = C.next_gcharptr(p) {
+		names = append(names, goString(*p))
+	}
+	return names
+}
+
+// CallSync is a wrapper around g_dbus_proxy_call_sync(). parameters is a
+// tuple holding the arguments, or nil if there are none.
+func (v *DBusProxy) CallSync(methodName string, parameters *Variant, flags DBusCallFlags, timeoutMsec int,
+	cancellable *Cancellable) (*Variant, error) {
+
+	cstr := (*C.gchar)(C.CString(methodName))
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_dbus_proxy_call_sync(v.native(), cstr, parameters.native(), C.GDBusCallFlags(flags),
+		C.gint(timeoutMsec), cancellable.native(), &err)
+	if c == nil {
+		return nil, takeError(err)
+	}
+	return assumeVariant(c), nil
+}
+
+// Call is a wrapper around g_dbus_proxy_call().
+func (v *DBusProxy) Call(methodName string, parameters *Variant, flags DBusCallFlags, timeoutMsec int,
+	cancellable *Cancellable, fn AsyncReadyCallback) {
+
+	cstr := (*C.gchar)(C.CString(methodName))
+	defer C.free(unsafe.Pointer(cstr))
+
+	C._g_dbus_proxy_call(v.native(), cstr, parameters.native(), C.GDBusCallFlags(flags),
+		C.gint(timeoutMsec), cancellable.native(), C.gpointer(callback.Assign(fn)))
+}
+
+// CallFinish is a wrapper around g_dbus_proxy_call_finish().
+func (v *DBusProxy) CallFinish(result *AsyncResult) (*Variant, error) {
+	var err *C.GError
+	c := C.g_dbus_proxy_call_finish(v.native(), result.native(), &err)
+	if c == nil {
+		return nil, takeError(err)
+	}
+	return assumeVariant(c), nil
+}
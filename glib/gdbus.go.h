@@ -0,0 +1,169 @@
+// Same copyright and license as the rest of the files in this project
+
+#ifndef __GDBUS_GO_H__
+#define __GDBUS_GO_H__
+
+#include <gio/gio.h>
+#include <stdlib.h>
+
+// This header must be included after glib.go.h, which declares
+// removeSourceFunc, and from a single Go file, as it defines the vtable of
+// exported objects.
+
+static inline GDBusConnection *toGDBusConnection(void *p) {
+  return (G_DBUS_CONNECTION(p));
+}
+
+static inline GDBusProxy *toGDBusProxy(void *p) { return (G_DBUS_PROXY(p)); }
+
+static inline GDBusMethodInvocation *toGDBusMethodInvocation(void *p) {
+  return (G_DBUS_METHOD_INVOCATION(p));
+}
+
+/*
+ * GAsyncReadyCallback
+ */
+
+extern void goAsyncReadyCallbacks(GObject *source_object, GAsyncResult *res,
+                                  gpointer user_data);
+
+static inline void _g_bus_get(GBusType bus_type, GCancellable *cancellable,
+                              gpointer user_data) {
+  g_bus_get(bus_type, cancellable, (GAsyncReadyCallback)(goAsyncReadyCallbacks),
+            user_data);
+}
+
+static inline void
+_g_dbus_connection_call(GDBusConnection *connection, const gchar *bus_name,
+                        const gchar *object_path, const gchar *interface_name,
+                        const gchar *method_name, GVariant *parameters,
+                        const GVariantType *reply_type, GDBusCallFlags flags,
+                        gint timeout_msec, GCancellable *cancellable,
+                        gpointer user_data) {
+  g_dbus_connection_call(connection, bus_name, object_path, interface_name,
+                         method_name, parameters, reply_type, flags,
+                         timeout_msec, cancellable,
+                         (GAsyncReadyCallback)(goAsyncReadyCallbacks),
+                         user_data);
+}
+
+static inline void _g_dbus_proxy_call(GDBusProxy *proxy,
+                                      const gchar *method_name,
+                                      GVariant *parameters,
+                                      GDBusCallFlags flags, gint timeout_msec,
+                                      GCancellable *cancellable,
+                                      gpointer user_data) {
+  g_dbus_proxy_call(proxy, method_name, parameters, flags, timeout_msec,
+                    cancellable, (GAsyncReadyCallback)(goAsyncReadyCallbacks),
+                    user_data);
+}
+
+/*
+ * GDBusSignalCallback
+ */
+
+extern void goDBusSignalCallback(GDBusConnection *connection,
+                                 gchar *sender_name, gchar *object_path,
+                                 gchar *interface_name, gchar *signal_name,
+                                 GVariant *parameters, gpointer user_data);
+
+static inline guint _g_dbus_connection_signal_subscribe(
+    GDBusConnection *connection, const gchar *sender,
+    const gchar *interface_name, const gchar *member, const gchar *object_path,
+    const gchar *arg0, GDBusSignalFlags flags, gpointer user_data) {
+  return g_dbus_connection_signal_subscribe(
+      connection, sender, interface_name, member, object_path, arg0, flags,
+      (GDBusSignalCallback)(goDBusSignalCallback), user_data,
+      (GDestroyNotify)(removeSourceFunc));
+}
+
+/*
+ * GDBusInterfaceVTable
+ */
+
+extern void goDBusMethodCall(GDBusConnection *connection, gchar *sender,
+                             gchar *object_path, gchar *interface_name,
+                             gchar *method_name, GVariant *parameters,
+                             GDBusMethodInvocation *invocation,
+                             gpointer user_data);
+
+extern GVariant *goDBusGetProperty(GDBusConnection *connection, gchar *sender,
+                                   gchar *object_path, gchar *interface_name,
+                                   gchar *property_name, char **error_message,
+                                   gpointer user_data);
+
+extern gboolean goDBusSetProperty(GDBusConnection *connection, gchar *sender,
+                                  gchar *object_path, gchar *interface_name,
+                                  gchar *property_name, GVariant *value,
+                                  char **error_message, gpointer user_data);
+
+// _g_dbus_set_error sets error from a message allocated by the Go side.
+static void _g_dbus_set_error(GError **error, char *message) {
+  g_set_error_literal(error, G_DBUS_ERROR, G_DBUS_ERROR_FAILED, message);
+  free(message);
+}
+
+static GVariant *_g_dbus_get_property(GDBusConnection *connection,
+                                      const gchar *sender,
+                                      const gchar *object_path,
+                                      const gchar *interface_name,
+                                      const gchar *property_name,
+                                      GError **error, gpointer user_data) {
+  char *message = NULL;
+  GVariant *value = goDBusGetProperty(
+      connection, (gchar *)sender, (gchar *)object_path,
+      (gchar *)interface_name, (gchar *)property_name, &message, user_data);
+  if (message != NULL) {
+    _g_dbus_set_error(error, message);
+  }
+  return value;
+}
+
+static gboolean _g_dbus_set_property(GDBusConnection *connection,
+                                     const gchar *sender,
+                                     const gchar *object_path,
+                                     const gchar *interface_name,
+                                     const gchar *property_name,
+                                     GVariant *value, GError **error,
+                                     gpointer user_data) {
+  char *message = NULL;
+  gboolean ok = goDBusSetProperty(
+      connection, (gchar *)sender, (gchar *)object_path,
+      (gchar *)interface_name, (gchar *)property_name, value, &message,
+      user_data);
+  if (message != NULL) {
+    _g_dbus_set_error(error, message);
+  }
+  return ok;
+}
+
+static const GDBusInterfaceVTable _g_dbus_interface_vtable = {
+    (GDBusInterfaceMethodCallFunc)(goDBusMethodCall), _g_dbus_get_property,
+    _g_dbus_set_property};
+
+static inline guint _g_dbus_connection_register_object(
+    GDBusConnection *connection, const gchar *object_path,
+    GDBusInterfaceInfo *interface_info, gpointer user_data, GError **error) {
+  return g_dbus_connection_register_object(
+      connection, object_path, interface_info, &_g_dbus_interface_vtable,
+      user_data, (GDestroyNotify)(removeSourceFunc), error);
+}
+
+/*
+ * GDBusNodeInfo
+ */
+
+static inline GDBusInterfaceInfo *
+_g_dbus_node_info_get_interface(GDBusNodeInfo *info, gint n) {
+  return info->interfaces[n];
+}
+
+static inline const gchar *
+_g_dbus_method_info_get_out_signature(GDBusMethodInfo *info, gint n) {
+  if (info->out_args == NULL || info->out_args[n] == NULL) {
+    return NULL;
+  }
+  return info->out_args[n]->signature;
+}
+
+#endif
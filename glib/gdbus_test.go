@@ -0,0 +1,193 @@
+package glib_test
+
+import (
+	"bufio"
+	"errors"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+const testDBusXML = `
+<node>
+  <interface name="org.gotk3.Test">
+    <method name="Greet">
+      <arg type="s" name="name" direction="in"/>
+      <arg type="u" name="times" direction="in"/>
+      <arg type="s" name="greeting" direction="out"/>
+    </method>
+    <method name="Fail"/>
+    <property type="i" name="Count" access="readwrite"/>
+    <signal name="Greeted">
+      <arg type="s" name="name"/>
+    </signal>
+  </interface>
+</node>`
+
+type testDBusObject struct {
+	count int32
+}
+
+func (o *testDBusObject) Greet(name string, times uint32) string {
+	return strings.Repeat("Hello "+name+"!", int(times))
+}
+
+func (o *testDBusObject) Fail() error {
+	return errors.New("failed on purpose")
+}
+
+func (o *testDBusObject) GetCount() int32 {
+	return o.count
+}
+
+func (o *testDBusObject) SetCount(count int32) {
+	o.count = count
+}
+
+// startDBusDaemon starts a private dbus-daemon and returns its address.
+func startDBusDaemon(t *testing.T) string {
+	path, err := exec.LookPath("dbus-daemon")
+	if err != nil {
+		t.Skip("dbus-daemon not available")
+	}
+
+	cmd := exec.Command(path, "--session", "--nofork", "--print-address")
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.Start(); err != nil {
+		t.Skip("cannot start dbus-daemon:", err)
+	}
+	t.Cleanup(func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	})
+
+	address, err := bufio.NewReader(stdout).ReadString('\n')
+	if err != nil {
+		t.Fatal("cannot read dbus-daemon address:", err)
+	}
+	return strings.TrimSpace(address)
+}
+
+func TestDBusNodeInfo(t *testing.T) {
+	node, err := glib.DBusNodeInfoNewForXML(testDBusXML)
+	if err != nil {
+		t.Fatal("DBusNodeInfoNewForXML failed:", err)
+	}
+
+	ifaces := node.GetInterfaces()
+	if len(ifaces) != 1 || ifaces[0].GetName() != "org.gotk3.Test" {
+		t.Fatal("Unexpected interfaces", ifaces)
+	}
+	if node.LookupInterface("org.gotk3.Missing") != nil {
+		t.Error("Expected no org.gotk3.Missing interface")
+	}
+
+	if _, err := glib.DBusNodeInfoNewForXML("<node"); err == nil {
+		t.Error("Expected an error for invalid XML")
+	}
+}
+
+func TestDBusExportObject(t *testing.T) {
+	address := startDBusDaemon(t)
+	flags := glib.DBUS_CONNECTION_FLAGS_AUTHENTICATION_CLIENT | glib.DBUS_CONNECTION_FLAGS_MESSAGE_BUS_CONNECTION
+
+	server, err := glib.DBusConnectionNewForAddressSync(address, flags, nil)
+	if err != nil {
+		t.Fatal("Cannot connect server:", err)
+	}
+	defer server.Close(nil)
+	client, err := glib.DBusConnectionNewForAddressSync(address, flags, nil)
+	if err != nil {
+		t.Fatal("Cannot connect client:", err)
+	}
+	defer client.Close(nil)
+
+	node, err := glib.DBusNodeInfoNewForXML(testDBusXML)
+	if err != nil {
+		t.Fatal("DBusNodeInfoNewForXML failed:", err)
+	}
+	obj := &testDBusObject{count: 3}
+	id, err := server.ExportObject("/org/gotk3/Test", node.LookupInterface("org.gotk3.Test"), obj)
+	if err != nil {
+		t.Fatal("ExportObject failed:", err)
+	}
+	defer server.UnregisterObject(id)
+
+	mainLoop := glib.MainLoopNew(glib.MainContextDefault(), false)
+	dest := server.GetUniqueName()
+
+	// Calls are dispatched by the main loop, so they must not block it.
+	call := func(iface, method string, params *glib.Variant) (*glib.Variant, error) {
+		var (
+			reply *glib.Variant
+			err   error
+		)
+		client.Call(dest, "/org/gotk3/Test", iface, method, params, nil,
+			glib.DBUS_CALL_FLAGS_NONE, -1, nil, func(_ *glib.Object, res *glib.AsyncResult) {
+				reply, err = client.CallFinish(res)
+				mainLoop.Quit()
+			})
+		mainLoop.Run()
+		return reply, err
+	}
+
+	reply, err := call("org.gotk3.Test", "Greet", glib.VariantNewTuple(glib.VariantFromString("Go"), glib.VariantFromUint32(2)))
+	if err != nil {
+		t.Fatal("Greet failed:", err)
+	}
+	var greeting struct{ Greeting string }
+	if err := reply.Decode(&greeting); err != nil {
+		t.Fatal("Decode failed:", err)
+	}
+	if greeting.Greeting != "Hello Go!Hello Go!" {
+		t.Errorf("Unexpected greeting %q", greeting.Greeting)
+	}
+
+	if _, err := call("org.gotk3.Test", "Fail", nil); err == nil || !strings.Contains(err.Error(), "failed on purpose") {
+		t.Error("Expected Fail to fail, got", err)
+	}
+
+	props := "org.freedesktop.DBus.Properties"
+	if _, err := call(props, "Set", glib.VariantNewTuple(glib.VariantFromString("org.gotk3.Test"),
+		glib.VariantFromString("Count"), glib.VariantFromVariant(glib.VariantFromInt32(5)))); err != nil {
+		t.Fatal("Setting Count failed:", err)
+	}
+	if obj.count != 5 {
+		t.Errorf("Expected count 5, got %d", obj.count)
+	}
+	reply, err = call(props, "Get", glib.VariantNewTuple(glib.VariantFromString("org.gotk3.Test"), glib.VariantFromString("Count")))
+	if err != nil {
+		t.Fatal("Getting Count failed:", err)
+	}
+	if count, _ := reply.GetChildValue(0).GetVariant().GetInt(); count != 5 {
+		t.Errorf("Expected Count 5, got %d", count)
+	}
+
+	var greeted string
+	sub := client.SignalSubscribe(dest, "org.gotk3.Test", "Greeted", "/org/gotk3/Test", "", glib.DBUS_SIGNAL_FLAGS_NONE,
+		func(_ *glib.DBusConnection, _, _, _, _ string, params *glib.Variant) {
+			greeted = params.GetChildValue(0).GetString()
+			mainLoop.Quit()
+		})
+	defer client.SignalUnsubscribe(sub)
+
+	// Make sure the match rule is installed before emitting the signal.
+	if _, err := client.CallSync("org.freedesktop.DBus", "/org/freedesktop/DBus", "org.freedesktop.DBus", "GetId",
+		nil, nil, glib.DBUS_CALL_FLAGS_NONE, -1, nil); err != nil {
+		t.Fatal("GetId failed:", err)
+	}
+
+	if err := server.EmitSignal("", "/org/gotk3/Test", "org.gotk3.Test", "Greeted",
+		glib.VariantNewTuple(glib.VariantFromString("Go"))); err != nil {
+		t.Fatal("EmitSignal failed:", err)
+	}
+	mainLoop.Run()
+	if greeted != "Go" {
+		t.Errorf("Expected Greeted(Go), got %q", greeted)
+	}
+}
@@ -0,0 +1,198 @@
+package glib
+
+// #include <gio/gio.h>
+// #include <glib.h>
+import "C"
+import (
+	"unsafe"
+)
+
+/*
+ * GError
+ */
+
+// Error is a representation of GLib's GError. The errors reported by GLib
+// and GTK functions are of this type, so that they can be told apart by
+// their domain and code rather than by their message, which is localized:
+//
+//	if errors.Is(err, glib.IOErrorNotFound) {
+//		...
+//	}
+type Error struct {
+	domain  Quark
+	code    int
+	message string
+}
+
+// ErrorNew creates an Error. It is mostly useful to define the sentinel
+// errors of a domain, which match any Error of the same domain and code
+// with errors.Is.
+func ErrorNew(domain Quark, code int, message string) *Error {
+	return &Error{domain: domain, code: code, message: message}
+}
+
+// Error returns the message of the error.
+func (e *Error) Error() string {
+	return e.message
+}
+
+// Domain returns the domain of the error, e.g. IOErrorQuark().
+func (e *Error) Domain() Quark {
+	return e.domain
+}
+
+// Code returns the code of the error, whose meaning depends on its domain.
+func (e *Error) Code() int {
+	return e.code
+}
+
+// Is reports whether target is an Error of the same domain and code,
+// regardless of their messages.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.domain == e.domain && t.code == e.code
+}
+
+// TakeError converts a GError to an Error and frees it. nil is returned
+// for a nil GError. This function is exported for visibility in other
+// gotk3 packages and is not meant to be used by applications.
+func TakeError(ptr unsafe.Pointer) error {
+	if ptr == nil {
+		return nil
+	}
+	return takeError((*C.GError)(ptr))
+}
+
+// takeError converts gerr to an Error and frees it.
+func takeError(gerr *C.GError) error {
+	if gerr == nil {
+		return nil
+	}
+	defer C.g_error_free(gerr)
+	return &Error{
+		domain:  Quark(gerr.domain),
+		code:    int(gerr.code),
+		message: goString(gerr.message),
+	}
+}
+
+/*
+ * GIOErrorEnum
+ */
+
+// IOErrorQuark is a wrapper around g_io_error_quark().
+func IOErrorQuark() Quark {
+	return Quark(C.g_io_error_quark())
+}
+
+// IOErrorEnum is a representation of GIO's GIOErrorEnum, the codes of the
+// errors of the IOErrorQuark() domain.
+type IOErrorEnum int
+
+const (
+	IO_ERROR_FAILED              IOErrorEnum = C.G_IO_ERROR_FAILED
+	IO_ERROR_NOT_FOUND           IOErrorEnum = C.G_IO_ERROR_NOT_FOUND
+	IO_ERROR_EXISTS              IOErrorEnum = C.G_IO_ERROR_EXISTS
+	IO_ERROR_IS_DIRECTORY        IOErrorEnum = C.G_IO_ERROR_IS_DIRECTORY
+	IO_ERROR_NOT_DIRECTORY       IOErrorEnum = C.G_IO_ERROR_NOT_DIRECTORY
+	IO_ERROR_NOT_EMPTY           IOErrorEnum = C.G_IO_ERROR_NOT_EMPTY
+	IO_ERROR_NOT_REGULAR_FILE    IOErrorEnum = C.G_IO_ERROR_NOT_REGULAR_FILE
+	IO_ERROR_NOT_SYMBOLIC_LINK   IOErrorEnum = C.G_IO_ERROR_NOT_SYMBOLIC_LINK
+	IO_ERROR_FILENAME_TOO_LONG   IOErrorEnum = C.G_IO_ERROR_FILENAME_TOO_LONG
+	IO_ERROR_INVALID_FILENAME    IOErrorEnum = C.G_IO_ERROR_INVALID_FILENAME
+	IO_ERROR_NO_SPACE            IOErrorEnum = C.G_IO_ERROR_NO_SPACE
+	IO_ERROR_INVALID_ARGUMENT    IOErrorEnum = C.G_IO_ERROR_INVALID_ARGUMENT
+	IO_ERROR_PERMISSION_DENIED   IOErrorEnum = C.G_IO_ERROR_PERMISSION_DENIED
+	IO_ERROR_NOT_SUPPORTED       IOErrorEnum = C.G_IO_ERROR_NOT_SUPPORTED
+	IO_ERROR_CLOSED              IOErrorEnum = C.G_IO_ERROR_CLOSED
+	IO_ERROR_CANCELLED           IOErrorEnum = C.G_IO_ERROR_CANCELLED
+	IO_ERROR_PENDING             IOErrorEnum = C.G_IO_ERROR_PENDING
+	IO_ERROR_READ_ONLY           IOErrorEnum = C.G_IO_ERROR_READ_ONLY
+	IO_ERROR_TIMED_OUT           IOErrorEnum = C.G_IO_ERROR_TIMED_OUT
+	IO_ERROR_BUSY                IOErrorEnum = C.G_IO_ERROR_BUSY
+	IO_ERROR_WOULD_BLOCK         IOErrorEnum = C.G_IO_ERROR_WOULD_BLOCK
+	IO_ERROR_HOST_NOT_FOUND      IOErrorEnum = C.G_IO_ERROR_HOST_NOT_FOUND
+	IO_ERROR_PARTIAL_INPUT       IOErrorEnum = C.G_IO_ERROR_PARTIAL_INPUT
+	IO_ERROR_INVALID_DATA        IOErrorEnum = C.G_IO_ERROR_INVALID_DATA
+	IO_ERROR_DBUS_ERROR          IOErrorEnum = C.G_IO_ERROR_DBUS_ERROR
+	IO_ERROR_HOST_UNREACHABLE    IOErrorEnum = C.G_IO_ERROR_HOST_UNREACHABLE
+	IO_ERROR_NETWORK_UNREACHABLE IOErrorEnum = C.G_IO_ERROR_NETWORK_UNREACHABLE
+	IO_ERROR_CONNECTION_REFUSED  IOErrorEnum = C.G_IO_ERROR_CONNECTION_REFUSED
+	IO_ERROR_BROKEN_PIPE         IOErrorEnum = C.G_IO_ERROR_BROKEN_PIPE
+)
+
+// Sentinel errors of the IOErrorQuark() domain, to be used with errors.Is.
+var (
+	IOErrorFailed            = ioError(IO_ERROR_FAILED, "operation failed")
+	IOErrorNotFound          = ioError(IO_ERROR_NOT_FOUND, "not found")
+	IOErrorExists            = ioError(IO_ERROR_EXISTS, "already exists")
+	IOErrorIsDirectory       = ioError(IO_ERROR_IS_DIRECTORY, "is a directory")
+	IOErrorNotDirectory      = ioError(IO_ERROR_NOT_DIRECTORY, "not a directory")
+	IOErrorNotEmpty          = ioError(IO_ERROR_NOT_EMPTY, "directory not empty")
+	IOErrorNotRegularFile    = ioError(IO_ERROR_NOT_REGULAR_FILE, "not a regular file")
+	IOErrorInvalidArgument   = ioError(IO_ERROR_INVALID_ARGUMENT, "invalid argument")
+	IOErrorPermissionDenied  = ioError(IO_ERROR_PERMISSION_DENIED, "permission denied")
+	IOErrorNotSupported      = ioError(IO_ERROR_NOT_SUPPORTED, "operation not supported")
+	IOErrorClosed            = ioError(IO_ERROR_CLOSED, "stream closed")
+	IOErrorCancelled         = ioError(IO_ERROR_CANCELLED, "operation cancelled")
+	IOErrorPending           = ioError(IO_ERROR_PENDING, "operation pending")
+	IOErrorTimedOut          = ioError(IO_ERROR_TIMED_OUT, "operation timed out")
+	IOErrorBusy              = ioError(IO_ERROR_BUSY, "resource busy")
+	IOErrorWouldBlock        = ioError(IO_ERROR_WOULD_BLOCK, "operation would block")
+	IOErrorHostNotFound      = ioError(IO_ERROR_HOST_NOT_FOUND, "host not found")
+	IOErrorInvalidData       = ioError(IO_ERROR_INVALID_DATA, "invalid data")
+	IOErrorDBusError         = ioError(IO_ERROR_DBUS_ERROR, "D-Bus error")
+	IOErrorConnectionRefused = ioError(IO_ERROR_CONNECTION_REFUSED, "connection refused")
+	IOErrorBrokenPipe        = ioError(IO_ERROR_BROKEN_PIPE, "broken pipe")
+)
+
+func ioError(code IOErrorEnum, message string) *Error {
+	return ErrorNew(IOErrorQuark(), int(code), message)
+}
+
+/*
+ * GFileError
+ */
+
+// FileErrorQuark is a wrapper around g_file_error_quark().
+func FileErrorQuark() Quark {
+	return Quark(C.g_file_error_quark())
+}
+
+// FileError is a representation of GLib's GFileError, the codes of the
+// errors of the FileErrorQuark() domain, named after the errno values.
+type FileError int
+
+const (
+	FILE_ERROR_EXIST       FileError = C.G_FILE_ERROR_EXIST
+	FILE_ERROR_ISDIR       FileError = C.G_FILE_ERROR_ISDIR
+	FILE_ERROR_ACCES       FileError = C.G_FILE_ERROR_ACCES
+	FILE_ERROR_NAMETOOLONG FileError = C.G_FILE_ERROR_NAMETOOLONG
+	FILE_ERROR_NOENT       FileError = C.G_FILE_ERROR_NOENT
+	FILE_ERROR_NOTDIR      FileError = C.G_FILE_ERROR_NOTDIR
+	FILE_ERROR_NOSPC       FileError = C.G_FILE_ERROR_NOSPC
+	FILE_ERROR_INVAL       FileError = C.G_FILE_ERROR_INVAL
+	FILE_ERROR_PERM        FileError = C.G_FILE_ERROR_PERM
+	FILE_ERROR_IO          FileError = C.G_FILE_ERROR_IO
+	FILE_ERROR_FAILED      FileError = C.G_FILE_ERROR_FAILED
+)
+
+// Sentinel errors of the FileErrorQuark() domain, to be used with
+// errors.Is.
+var (
+	FileErrorExist       = fileError(FILE_ERROR_EXIST, "file exists")
+	FileErrorIsDir       = fileError(FILE_ERROR_ISDIR, "is a directory")
+	FileErrorAcces       = fileError(FILE_ERROR_ACCES, "permission denied")
+	FileErrorNameTooLong = fileError(FILE_ERROR_NAMETOOLONG, "file name too long")
+	FileErrorNoEnt       = fileError(FILE_ERROR_NOENT, "no such file or directory")
+	FileErrorNotDir      = fileError(FILE_ERROR_NOTDIR, "not a directory")
+	FileErrorNoSpc       = fileError(FILE_ERROR_NOSPC, "no space left on device")
+	FileErrorInval       = fileError(FILE_ERROR_INVAL, "invalid argument")
+	FileErrorPerm        = fileError(FILE_ERROR_PERM, "operation not permitted")
+	FileErrorIO          = fileError(FILE_ERROR_IO, "input/output error")
+	FileErrorFailed      = fileError(FILE_ERROR_FAILED, "operation failed")
+)
+
+func fileError(code FileError, message string) *Error {
+	return ErrorNew(FileErrorQuark(), int(code), message)
+}
@@ -0,0 +1,64 @@
+package glib_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func TestErrorDomainAndCode(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "gotk3-gerror")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	file, err := glib.FileNewForPath(filepath.Join(tmp, "missing"))
+	if err != nil {
+		t.Fatal("FileNewForPath failed:", err)
+	}
+
+	_, _, err = file.LoadContents(nil)
+	if !errors.Is(err, glib.IOErrorNotFound) {
+		t.Fatalf("expected IOErrorNotFound, got %v", err)
+	}
+	if errors.Is(err, glib.IOErrorExists) {
+		t.Error("error matches IOErrorExists")
+	}
+
+	var gerr *glib.Error
+	if !errors.As(err, &gerr) {
+		t.Fatalf("expected a *glib.Error, got %T", err)
+	}
+	if gerr.Domain() != glib.IOErrorQuark() {
+		t.Errorf("expected domain %d, got %d", glib.IOErrorQuark(), gerr.Domain())
+	}
+	if gerr.Code() != int(glib.IO_ERROR_NOT_FOUND) {
+		t.Errorf("expected code %d, got %d", glib.IO_ERROR_NOT_FOUND, gerr.Code())
+	}
+	if gerr.Error() == "" {
+		t.Error("expected a message")
+	}
+
+	dir, err := glib.FileNewForPath(tmp)
+	if err != nil {
+		t.Fatal("FileNewForPath failed:", err)
+	}
+	if err := dir.MakeDirectory(nil); !errors.Is(err, glib.IOErrorExists) {
+		t.Errorf("expected IOErrorExists, got %v", err)
+	}
+}
+
+func TestErrorIs(t *testing.T) {
+	err := glib.ErrorNew(glib.FileErrorQuark(), int(glib.FILE_ERROR_NOENT), "localized message")
+	if !errors.Is(err, glib.FileErrorNoEnt) {
+		t.Error("error does not match FileErrorNoEnt")
+	}
+	if errors.Is(err, glib.IOErrorNotFound) {
+		t.Error("error of another domain matches IOErrorNotFound")
+	}
+}
@@ -7,8 +7,9 @@ package glib
 // #include "gfile.go.h"
 import "C"
 import (
-	"errors"
 	"unsafe"
+
+	"github.com/gotk3/gotk3/internal/callback"
 )
 
 func init() {
@@ -17,6 +18,11 @@ func init() {
 		{Type(C.g_file_get_type()), marshalFile},
 		{Type(C.g_file_input_stream_get_type()), marshalFileInputStream},
 		{Type(C.g_file_output_stream_get_type()), marshalFileOutputStream},
+		{Type(C.g_file_info_get_type()), marshalFileInfo},
+		{Type(C.g_file_enumerator_get_type()), marshalFileEnumerator},
+
+		// Enums
+		{Type(C.g_file_type_get_type()), marshalFileType},
 	}
 
 	RegisterGValueMarshalers(tm)
@@ -26,6 +32,58 @@ func goString(cstr *C.gchar) string {
 	return C.GoString((*C.char)(cstr))
 }
 
+/*
+ * Enums
+ */
+
+// FileCreateFlags is a representation of GIO's GFileCreateFlags.
+type FileCreateFlags int
+
+const (
+	FILE_CREATE_NONE                FileCreateFlags = C.G_FILE_CREATE_NONE
+	FILE_CREATE_PRIVATE             FileCreateFlags = C.G_FILE_CREATE_PRIVATE
+	FILE_CREATE_REPLACE_DESTINATION FileCreateFlags = C.G_FILE_CREATE_REPLACE_DESTINATION
+)
+
+// FileCopyFlags is a representation of GIO's GFileCopyFlags.
+type FileCopyFlags int
+
+const (
+	FILE_COPY_NONE                 FileCopyFlags = C.G_FILE_COPY_NONE
+	FILE_COPY_OVERWRITE            FileCopyFlags = C.G_FILE_COPY_OVERWRITE
+	FILE_COPY_BACKUP               FileCopyFlags = C.G_FILE_COPY_BACKUP
+	FILE_COPY_NOFOLLOW_SYMLINKS    FileCopyFlags = C.G_FILE_COPY_NOFOLLOW_SYMLINKS
+	FILE_COPY_ALL_METADATA         FileCopyFlags = C.G_FILE_COPY_ALL_METADATA
+	FILE_COPY_NO_FALLBACK_FOR_MOVE FileCopyFlags = C.G_FILE_COPY_NO_FALLBACK_FOR_MOVE
+	FILE_COPY_TARGET_DEFAULT_PERMS FileCopyFlags = C.G_FILE_COPY_TARGET_DEFAULT_PERMS
+)
+
+// FileQueryInfoFlags is a representation of GIO's GFileQueryInfoFlags.
+type FileQueryInfoFlags int
+
+const (
+	FILE_QUERY_INFO_NONE              FileQueryInfoFlags = C.G_FILE_QUERY_INFO_NONE
+	FILE_QUERY_INFO_NOFOLLOW_SYMLINKS FileQueryInfoFlags = C.G_FILE_QUERY_INFO_NOFOLLOW_SYMLINKS
+)
+
+// FileType is a representation of GIO's GFileType.
+type FileType int
+
+const (
+	FILE_TYPE_UNKNOWN       FileType = C.G_FILE_TYPE_UNKNOWN
+	FILE_TYPE_REGULAR       FileType = C.G_FILE_TYPE_REGULAR
+	FILE_TYPE_DIRECTORY     FileType = C.G_FILE_TYPE_DIRECTORY
+	FILE_TYPE_SYMBOLIC_LINK FileType = C.G_FILE_TYPE_SYMBOLIC_LINK
+	FILE_TYPE_SPECIAL       FileType = C.G_FILE_TYPE_SPECIAL
+	FILE_TYPE_SHORTCUT      FileType = C.G_FILE_TYPE_SHORTCUT
+	FILE_TYPE_MOUNTABLE     FileType = C.G_FILE_TYPE_MOUNTABLE
+)
+
+func marshalFileType(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return FileType(c), nil
+}
+
 /*
  * GFile
  */
@@ -90,23 +148,95 @@ func FileNewForPath(path string) (*File, error) {
 	return wrapFile(Take(unsafe.Pointer(c))), nil
 }
 
+// FileNewForURI is a wrapper around g_file_new_for_uri().
+func FileNewForURI(uri string) (*File, error) {
+	cstr := (*C.char)(C.CString(uri))
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_file_new_for_uri(cstr)
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapFile(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// FileNewForCommandlineArg is a wrapper around
+// g_file_new_for_commandline_arg().
+func FileNewForCommandlineArg(arg string) (*File, error) {
+	cstr := (*C.char)(C.CString(arg))
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_file_new_for_commandline_arg(cstr)
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapFile(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// FileParseName is a wrapper around g_file_parse_name().
+func FileParseName(parseName string) (*File, error) {
+	cstr := (*C.char)(C.CString(parseName))
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_file_parse_name(cstr)
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapFile(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
 // TODO g_file_*** and more
 /*
-void 	(*GFileProgressCallback) ()
-gboolean 	(*GFileReadMoreCallback) ()
+void 	(*GFileReadMoreCallback) ()
 void 	(*GFileMeasureProgressCallback) ()
-GFile * 	g_file_new_for_uri ()
-GFile * 	g_file_new_for_commandline_arg ()
 GFile * 	g_file_new_for_commandline_arg_and_cwd ()
 GFile * 	g_file_new_tmp ()
-GFile * 	g_file_parse_name ()
 GFile * 	g_file_new_build_filename ()
-GFile * 	g_file_dup ()
 guint 	g_file_hash ()
-gboolean 	g_file_equal ()
-char * 	g_file_get_basename ()
 */
 
+// Dup is a wrapper around g_file_dup().
+func (v *File) Dup() *File {
+	c := C.g_file_dup(v.native())
+	return wrapFile(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// Equal is a wrapper around g_file_equal().
+func (v *File) Equal(file *File) bool {
+	return gobool(C.g_file_equal(v.native(), file.native()))
+}
+
+// fileString converts a string returned by a GFile function, which the
+// caller must free, and frees it.
+func fileString(c *C.char) string {
+	if c == nil {
+		return ""
+	}
+	defer C.g_free(C.gpointer(c))
+	return C.GoString(c)
+}
+
+// goByteSlice returns a copy of the n bytes at p. Unlike C.GoBytes, which
+// takes a C int, it copies buffers larger than 2 GiB in full.
+func goByteSlice(p unsafe.Pointer, n C.gsize) []byte {
+	const chunk = 1 << 30
+
+	b := make([]byte, int(n))
+	for off := 0; off < len(b); off += chunk {
+		m := len(b) - off
+		if m > chunk {
+			m = chunk
+		}
+		copy(b[off:], (*[chunk]byte)(unsafe.Pointer(uintptr(p) + uintptr(off)))[:m:m])
+	}
+	return b
+}
+
+// GetBasename is a wrapper around g_file_get_basename().
+func (v *File) GetBasename() string {
+	return fileString(C.g_file_get_basename(v.native()))
+}
+
 /*
 char *
 g_file_get_path (GFile *file);
@@ -122,20 +252,77 @@ func (v *File) GetPath() string {
 	return s
 }
 
+// GetURI is a wrapper around g_file_get_uri().
+func (v *File) GetURI() string {
+	return fileString(C.g_file_get_uri(v.native()))
+}
+
+// GetParseName is a wrapper around g_file_get_parse_name().
+func (v *File) GetParseName() string {
+	return fileString(C.g_file_get_parse_name(v.native()))
+}
+
+// GetParent is a wrapper around g_file_get_parent(). It returns nil if the
+// file is the root of its file system.
+func (v *File) GetParent() *File {
+	c := C.g_file_get_parent(v.native())
+	if c == nil {
+		return nil
+	}
+	return wrapFile(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// GetChild is a wrapper around g_file_get_child().
+func (v *File) GetChild(name string) *File {
+	cstr := (*C.char)(C.CString(name))
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_file_get_child(v.native(), cstr)
+	return wrapFile(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// HasPrefix is a wrapper around g_file_has_prefix().
+func (v *File) HasPrefix(prefix *File) bool {
+	return gobool(C.g_file_has_prefix(v.native(), prefix.native()))
+}
+
+// GetRelativePath is a wrapper around g_file_get_relative_path(). It returns
+// an empty string if descendant is not a descendant of the file.
+func (v *File) GetRelativePath(descendant *File) string {
+	return fileString(C.g_file_get_relative_path(v.native(), descendant.native()))
+}
+
+// ResolveRelativePath is a wrapper around g_file_resolve_relative_path().
+func (v *File) ResolveRelativePath(relativePath string) *File {
+	cstr := (*C.char)(C.CString(relativePath))
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_file_resolve_relative_path(v.native(), cstr)
+	return wrapFile(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// IsNative is a wrapper around g_file_is_native().
+func (v *File) IsNative() bool {
+	return gobool(C.g_file_is_native(v.native()))
+}
+
+// HasURIScheme is a wrapper around g_file_has_uri_scheme().
+func (v *File) HasURIScheme(uriScheme string) bool {
+	cstr := (*C.char)(C.CString(uriScheme))
+	defer C.free(unsafe.Pointer(cstr))
+
+	return gobool(C.g_file_has_uri_scheme(v.native(), cstr))
+}
+
+// GetURIScheme is a wrapper around g_file_get_uri_scheme().
+func (v *File) GetURIScheme() string {
+	return fileString(C.g_file_get_uri_scheme(v.native()))
+}
+
 /*
 const char * 	g_file_peek_path ()
-char * 	g_file_get_uri ()
-char * 	g_file_get_parse_name ()
-GFile * 	g_file_get_parent ()
 gboolean 	g_file_has_parent ()
-GFile * 	g_file_get_child ()
 GFile * 	g_file_get_child_for_display_name ()
-gboolean 	g_file_has_prefix ()
-char * 	g_file_get_relative_path ()
-GFile * 	g_file_resolve_relative_path ()
-gboolean 	g_file_is_native ()
-gboolean 	g_file_has_uri_scheme ()
-char * 	g_file_get_uri_scheme ()
 */
 
 /*
@@ -153,29 +340,298 @@ func (v *File) Read(cancellable *Cancellable) (*FileInputStream, error) {
 		cancellable.native(),
 		&gerr)
 	if c == nil {
-		defer C.g_error_free(gerr)
-		return nil, errors.New(goString(gerr.message))
+		return nil, takeError(gerr)
 	}
 	return wrapFileInputStream(Take(unsafe.Pointer(c))), nil
 }
 
+// AppendTo is a wrapper around g_file_append_to().
+func (v *File) AppendTo(flags FileCreateFlags, cancellable *Cancellable) (*FileOutputStream, error) {
+	var gerr *C.GError
+	c := C.g_file_append_to(v.native(), C.GFileCreateFlags(flags), cancellable.native(), &gerr)
+	if c == nil {
+		return nil, takeError(gerr)
+	}
+	return wrapFileOutputStream(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// Create is a wrapper around g_file_create(). It fails if the file already
+// exists.
+func (v *File) Create(flags FileCreateFlags, cancellable *Cancellable) (*FileOutputStream, error) {
+	var gerr *C.GError
+	c := C.g_file_create(v.native(), C.GFileCreateFlags(flags), cancellable.native(), &gerr)
+	if c == nil {
+		return nil, takeError(gerr)
+	}
+	return wrapFileOutputStream(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// Replace is a wrapper around g_file_replace(). etag may be empty to skip
+// the check for changes of the current file.
+func (v *File) Replace(etag string, makeBackup bool, flags FileCreateFlags, cancellable *Cancellable) (*FileOutputStream, error) {
+	var cstr *C.char
+	if etag != "" {
+		cstr = C.CString(etag)
+		defer C.free(unsafe.Pointer(cstr))
+	}
+
+	var gerr *C.GError
+	c := C.g_file_replace(v.native(), cstr, gbool(makeBackup), C.GFileCreateFlags(flags), cancellable.native(), &gerr)
+	if c == nil {
+		return nil, takeError(gerr)
+	}
+	return wrapFileOutputStream(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// QueryInfo is a wrapper around g_file_query_info(). attributes is a
+// comma-separated list of attributes or namespaces, like
+// "standard::*,time::modified".
+func (v *File) QueryInfo(attributes string, flags FileQueryInfoFlags, cancellable *Cancellable) (*FileInfo, error) {
+	cstr := (*C.char)(C.CString(attributes))
+	defer C.free(unsafe.Pointer(cstr))
+
+	var gerr *C.GError
+	c := C.g_file_query_info(v.native(), cstr, C.GFileQueryInfoFlags(flags), cancellable.native(), &gerr)
+	if c == nil {
+		return nil, takeError(gerr)
+	}
+	return wrapFileInfo(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// QueryExists is a wrapper around g_file_query_exists().
+func (v *File) QueryExists(cancellable *Cancellable) bool {
+	return gobool(C.g_file_query_exists(v.native(), cancellable.native()))
+}
+
+// QueryFileType is a wrapper around g_file_query_file_type().
+func (v *File) QueryFileType(flags FileQueryInfoFlags, cancellable *Cancellable) FileType {
+	return FileType(C.g_file_query_file_type(v.native(), C.GFileQueryInfoFlags(flags), cancellable.native()))
+}
+
+// EnumerateChildren is a wrapper around g_file_enumerate_children().
+func (v *File) EnumerateChildren(attributes string, flags FileQueryInfoFlags, cancellable *Cancellable) (*FileEnumerator, error) {
+	cstr := (*C.char)(C.CString(attributes))
+	defer C.free(unsafe.Pointer(cstr))
+
+	var gerr *C.GError
+	c := C.g_file_enumerate_children(v.native(), cstr, C.GFileQueryInfoFlags(flags), cancellable.native(), &gerr)
+	if c == nil {
+		return nil, takeError(gerr)
+	}
+	return wrapFileEnumerator(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// Delete is a wrapper around g_file_delete().
+func (v *File) Delete(cancellable *Cancellable) error {
+	var gerr *C.GError
+	if !gobool(C.g_file_delete(v.native(), cancellable.native(), &gerr)) {
+		return takeError(gerr)
+	}
+	return nil
+}
+
+// Trash is a wrapper around g_file_trash().
+func (v *File) Trash(cancellable *Cancellable) error {
+	var gerr *C.GError
+	if !gobool(C.g_file_trash(v.native(), cancellable.native(), &gerr)) {
+		return takeError(gerr)
+	}
+	return nil
+}
+
+// FileProgressCallback is a representation of GIO's GFileProgressCallback.
+type FileProgressCallback func(currentNumBytes, totalNumBytes int64)
+
+//export goFileProgressCallback
+func goFileProgressCallback(currentNumBytes, totalNumBytes C.goffset, userData C.gpointer) {
+	fn := callback.Get(uintptr(userData)).(FileProgressCallback)
+	fn(int64(currentNumBytes), int64(totalNumBytes))
+}
+
+// Copy is a wrapper around g_file_copy(). progress may be nil, it is called
+// in the calling goroutine before Copy returns.
+func (v *File) Copy(destination *File, flags FileCopyFlags, cancellable *Cancellable, progress FileProgressCallback) error {
+	var id uintptr
+	if progress != nil {
+		id = callback.Assign(progress)
+		defer callback.Delete(id)
+	}
+
+	var gerr *C.GError
+	c := C._g_file_copy(v.native(), destination.native(), C.GFileCopyFlags(flags), cancellable.native(),
+		gbool(progress != nil), C.gpointer(id), &gerr)
+	if !gobool(c) {
+		return takeError(gerr)
+	}
+	return nil
+}
+
+// Move is a wrapper around g_file_move(). progress may be nil, it is called
+// in the calling goroutine before Move returns.
+func (v *File) Move(destination *File, flags FileCopyFlags, cancellable *Cancellable, progress FileProgressCallback) error {
+	var id uintptr
+	if progress != nil {
+		id = callback.Assign(progress)
+		defer callback.Delete(id)
+	}
+
+	var gerr *C.GError
+	c := C._g_file_move(v.native(), destination.native(), C.GFileCopyFlags(flags), cancellable.native(),
+		gbool(progress != nil), C.gpointer(id), &gerr)
+	if !gobool(c) {
+		return takeError(gerr)
+	}
+	return nil
+}
+
+// MakeDirectory is a wrapper around g_file_make_directory().
+func (v *File) MakeDirectory(cancellable *Cancellable) error {
+	var gerr *C.GError
+	if !gobool(C.g_file_make_directory(v.native(), cancellable.native(), &gerr)) {
+		return takeError(gerr)
+	}
+	return nil
+}
+
+// MakeDirectoryWithParents is a wrapper around
+// g_file_make_directory_with_parents().
+func (v *File) MakeDirectoryWithParents(cancellable *Cancellable) error {
+	var gerr *C.GError
+	if !gobool(C.g_file_make_directory_with_parents(v.native(), cancellable.native(), &gerr)) {
+		return takeError(gerr)
+	}
+	return nil
+}
+
+// MakeSymbolicLink is a wrapper around g_file_make_symbolic_link().
+func (v *File) MakeSymbolicLink(symlinkValue string, cancellable *Cancellable) error {
+	cstr := (*C.char)(C.CString(symlinkValue))
+	defer C.free(unsafe.Pointer(cstr))
+
+	var gerr *C.GError
+	if !gobool(C.g_file_make_symbolic_link(v.native(), cstr, cancellable.native(), &gerr)) {
+		return takeError(gerr)
+	}
+	return nil
+}
+
+// LoadContents is a wrapper around g_file_load_contents(). It returns the
+// contents of the file and its entity tag.
+func (v *File) LoadContents(cancellable *Cancellable) ([]byte, string, error) {
+	var (
+		contents *C.char
+		length   C.gsize
+		etag     *C.char
+		gerr     *C.GError
+	)
+	if !gobool(C.g_file_load_contents(v.native(), cancellable.native(), &contents, &length, &etag, &gerr)) {
+		return nil, "", takeError(gerr)
+	}
+	defer C.g_free(C.gpointer(contents))
+	return goByteSlice(unsafe.Pointer(contents), length), fileString(etag), nil
+}
+
+// ReplaceContents is a wrapper around g_file_replace_contents(). etag may be
+// empty, and the entity tag of the new file is returned.
+func (v *File) ReplaceContents(contents []byte, etag string, makeBackup bool, flags FileCreateFlags,
+	cancellable *Cancellable) (string, error) {
+
+	var cetag *C.char
+	if etag != "" {
+		cetag = C.CString(etag)
+		defer C.free(unsafe.Pointer(cetag))
+	}
+	ccontents := C.CBytes(contents)
+	defer C.free(ccontents)
+
+	var (
+		newEtag *C.char
+		gerr    *C.GError
+	)
+	c := C.g_file_replace_contents(v.native(), (*C.char)(ccontents), C.gsize(len(contents)), cetag,
+		gbool(makeBackup), C.GFileCreateFlags(flags), &newEtag, cancellable.native(), &gerr)
+	if !gobool(c) {
+		return "", takeError(gerr)
+	}
+	return fileString(newEtag), nil
+}
+
+// ReadAsync is a wrapper around g_file_read_async().
+func (v *File) ReadAsync(ioPriority Priority, cancellable *Cancellable, fn AsyncReadyCallback) {
+	C._g_file_read_async(v.native(), C.int(ioPriority), cancellable.native(), C.gpointer(callback.Assign(fn)))
+}
+
+// ReadFinish is a wrapper around g_file_read_finish().
+func (v *File) ReadFinish(result *AsyncResult) (*FileInputStream, error) {
+	var gerr *C.GError
+	c := C.g_file_read_finish(v.native(), result.native(), &gerr)
+	if c == nil {
+		return nil, takeError(gerr)
+	}
+	return wrapFileInputStream(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// LoadContentsAsync is a wrapper around g_file_load_contents_async().
+func (v *File) LoadContentsAsync(cancellable *Cancellable, fn AsyncReadyCallback) {
+	C._g_file_load_contents_async(v.native(), cancellable.native(), C.gpointer(callback.Assign(fn)))
+}
+
+// LoadContentsFinish is a wrapper around g_file_load_contents_finish(). It
+// returns the contents of the file and its entity tag.
+func (v *File) LoadContentsFinish(result *AsyncResult) ([]byte, string, error) {
+	var (
+		contents *C.char
+		length   C.gsize
+		etag     *C.char
+		gerr     *C.GError
+	)
+	if !gobool(C.g_file_load_contents_finish(v.native(), result.native(), &contents, &length, &etag, &gerr)) {
+		return nil, "", takeError(gerr)
+	}
+	defer C.g_free(C.gpointer(contents))
+	return goByteSlice(unsafe.Pointer(contents), length), fileString(etag), nil
+}
+
+// ReplaceContentsAsync is a wrapper around
+// g_file_replace_contents_bytes_async(). contents is copied, so it may be
+// modified once ReplaceContentsAsync returns.
+func (v *File) ReplaceContentsAsync(contents []byte, etag string, makeBackup bool, flags FileCreateFlags,
+	cancellable *Cancellable, fn AsyncReadyCallback) {
+
+	var cetag *C.char
+	if etag != "" {
+		cetag = C.CString(etag)
+		defer C.free(unsafe.Pointer(cetag))
+	}
+	b := newGBytes(contents)
+	defer C.g_bytes_unref(b)
+
+	C._g_file_replace_contents_bytes_async(v.native(), b, cetag, gbool(makeBackup), C.GFileCreateFlags(flags),
+		cancellable.native(), C.gpointer(callback.Assign(fn)))
+}
+
+// ReplaceContentsFinish is a wrapper around g_file_replace_contents_finish().
+// It returns the entity tag of the new file.
+func (v *File) ReplaceContentsFinish(result *AsyncResult) (string, error) {
+	var (
+		newEtag *C.char
+		gerr    *C.GError
+	)
+	if !gobool(C.g_file_replace_contents_finish(v.native(), result.native(), &newEtag, &gerr)) {
+		return "", takeError(gerr)
+	}
+	return fileString(newEtag), nil
+}
+
 /*
-void 	g_file_read_async ()
-GFileInputStream * 	g_file_read_finish ()
-GFileOutputStream * 	g_file_append_to ()
-GFileOutputStream * 	g_file_create ()
-GFileOutputStream * 	g_file_replace ()
 void 	g_file_append_to_async ()
 GFileOutputStream * 	g_file_append_to_finish ()
 void 	g_file_create_async ()
 GFileOutputStream * 	g_file_create_finish ()
 void 	g_file_replace_async ()
 GFileOutputStream * 	g_file_replace_finish ()
-GFileInfo * 	g_file_query_info ()
 void 	g_file_query_info_async ()
 GFileInfo * 	g_file_query_info_finish ()
-gboolean 	g_file_query_exists ()
-GFileType 	g_file_query_file_type ()
 GFileInfo * 	g_file_query_filesystem_info ()
 void 	g_file_query_filesystem_info_async ()
 GFileInfo * 	g_file_query_filesystem_info_finish ()
@@ -188,27 +644,19 @@ gboolean 	g_file_measure_disk_usage_finish ()
 GMount * 	g_file_find_enclosing_mount ()
 void 	g_file_find_enclosing_mount_async ()
 GMount * 	g_file_find_enclosing_mount_finish ()
-GFileEnumerator * 	g_file_enumerate_children ()
 void 	g_file_enumerate_children_async ()
 GFileEnumerator * 	g_file_enumerate_children_finish ()
 GFile * 	g_file_set_display_name ()
 void 	g_file_set_display_name_async ()
 GFile * 	g_file_set_display_name_finish ()
-gboolean 	g_file_delete ()
 void 	g_file_delete_async ()
 gboolean 	g_file_delete_finish ()
-gboolean 	g_file_trash ()
 void 	g_file_trash_async ()
 gboolean 	g_file_trash_finish ()
-gboolean 	g_file_copy ()
 void 	g_file_copy_async ()
 gboolean 	g_file_copy_finish ()
-gboolean 	g_file_move ()
-gboolean 	g_file_make_directory ()
 void 	g_file_make_directory_async ()
 gboolean 	g_file_make_directory_finish ()
-gboolean 	g_file_make_directory_with_parents ()
-gboolean 	g_file_make_symbolic_link ()
 GFileAttributeInfoList * 	g_file_query_settable_attributes ()
 GFileAttributeInfoList * 	g_file_query_writable_namespaces ()
 gboolean 	g_file_set_attribute ()
@@ -239,21 +687,12 @@ void 	g_file_poll_mountable ()
 gboolean 	g_file_poll_mountable_finish ()
 void 	g_file_mount_enclosing_volume ()
 gboolean 	g_file_mount_enclosing_volume_finish ()
-GFileMonitor * 	g_file_monitor_directory ()
-GFileMonitor * 	g_file_monitor_file ()
-GFileMonitor * 	g_file_monitor ()
 GBytes * 	g_file_load_bytes ()
 void 	g_file_load_bytes_async ()
 GBytes * 	g_file_load_bytes_finish ()
-gboolean 	g_file_load_contents ()
-void 	g_file_load_contents_async ()
-gboolean 	g_file_load_contents_finish ()
+void 	g_file_replace_contents_async ()
 void 	g_file_load_partial_contents_async ()
 gboolean 	g_file_load_partial_contents_finish ()
-gboolean 	g_file_replace_contents ()
-void 	g_file_replace_contents_async ()
-void 	g_file_replace_contents_bytes_async ()
-gboolean 	g_file_replace_contents_finish ()
 gboolean 	g_file_copy_attributes ()
 GFileIOStream * 	g_file_create_readwrite ()
 void 	g_file_create_readwrite_async ()
@@ -11,3 +11,71 @@ static GFileInputStream *toGFileInputStream(void *p) {
 static GFileOutputStream *toGFileOutputStream(void *p) {
   return (G_FILE_OUTPUT_STREAM(p));
 }
+
+static GFileInfo *toGFileInfo(void *p) { return (G_FILE_INFO(p)); }
+
+static GFileEnumerator *toGFileEnumerator(void *p) {
+  return (G_FILE_ENUMERATOR(p));
+}
+
+static GFileMonitor *toGFileMonitor(void *p) { return (G_FILE_MONITOR(p)); }
+
+/*
+ * GFileProgressCallback
+ */
+
+extern void goFileProgressCallback(goffset current_num_bytes,
+                                   goffset total_num_bytes,
+                                   gpointer user_data);
+
+static inline gboolean _g_file_copy(GFile *source, GFile *destination,
+                                    GFileCopyFlags flags,
+                                    GCancellable *cancellable,
+                                    gboolean has_progress, gpointer user_data,
+                                    GError **error) {
+  return g_file_copy(
+      source, destination, flags, cancellable,
+      has_progress ? (GFileProgressCallback)(goFileProgressCallback) : NULL,
+      user_data, error);
+}
+
+static inline gboolean _g_file_move(GFile *source, GFile *destination,
+                                    GFileCopyFlags flags,
+                                    GCancellable *cancellable,
+                                    gboolean has_progress, gpointer user_data,
+                                    GError **error) {
+  return g_file_move(
+      source, destination, flags, cancellable,
+      has_progress ? (GFileProgressCallback)(goFileProgressCallback) : NULL,
+      user_data, error);
+}
+
+/*
+ * GAsyncReadyCallback
+ */
+
+extern void goAsyncReadyCallbacks(GObject *source_object, GAsyncResult *res,
+                                  gpointer user_data);
+
+static inline void _g_file_read_async(GFile *file, int io_priority,
+                                      GCancellable *cancellable,
+                                      gpointer user_data) {
+  g_file_read_async(file, io_priority, cancellable,
+                    (GAsyncReadyCallback)(goAsyncReadyCallbacks), user_data);
+}
+
+static inline void _g_file_load_contents_async(GFile *file,
+                                               GCancellable *cancellable,
+                                               gpointer user_data) {
+  g_file_load_contents_async(file, cancellable,
+                             (GAsyncReadyCallback)(goAsyncReadyCallbacks),
+                             user_data);
+}
+
+static inline void _g_file_replace_contents_bytes_async(
+    GFile *file, GBytes *contents, const char *etag, gboolean make_backup,
+    GFileCreateFlags flags, GCancellable *cancellable, gpointer user_data) {
+  g_file_replace_contents_bytes_async(
+      file, contents, etag, make_backup, flags, cancellable,
+      (GAsyncReadyCallback)(goAsyncReadyCallbacks), user_data);
+}
@@ -0,0 +1,124 @@
+package glib_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func TestFileOperations(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "gotk3-gfile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	dir, err := glib.FileNewForPath(tmp)
+	if err != nil {
+		t.Fatal("FileNewForPath failed:", err)
+	}
+
+	sub := dir.GetChild("sub")
+	if err := sub.MakeDirectory(nil); err != nil {
+		t.Fatal("MakeDirectory failed:", err)
+	}
+
+	src := dir.GetChild("a.txt")
+	out, err := src.Create(glib.FILE_CREATE_NONE, nil)
+	if err != nil {
+		t.Fatal("Create failed:", err)
+	}
+	if _, err := out.Write(bytes.NewBufferString("hello"), nil); err != nil {
+		t.Fatal("Write failed:", err)
+	}
+	if _, err := out.Close(nil); err != nil {
+		t.Fatal("Close failed:", err)
+	}
+	if _, err := src.Create(glib.FILE_CREATE_NONE, nil); err == nil {
+		t.Error("Expected Create to fail on an existing file")
+	}
+
+	out, err = src.AppendTo(glib.FILE_CREATE_NONE, nil)
+	if err != nil {
+		t.Fatal("AppendTo failed:", err)
+	}
+	out.Write(bytes.NewBufferString(" world"), nil)
+	out.Close(nil)
+
+	info, err := src.QueryInfo("standard::*,time::modified", glib.FILE_QUERY_INFO_NONE, nil)
+	if err != nil {
+		t.Fatal("QueryInfo failed:", err)
+	}
+	if info.GetName() != "a.txt" || info.GetSize() != 11 || info.GetFileType() != glib.FILE_TYPE_REGULAR {
+		t.Errorf("Unexpected info: name %q, size %d, type %d", info.GetName(), info.GetSize(), info.GetFileType())
+	}
+	if info.GetModificationTime().IsZero() {
+		t.Error("Expected a modification time")
+	}
+
+	var progressed bool
+	dst := sub.GetChild("b.txt")
+	err = src.Copy(dst, glib.FILE_COPY_NONE, nil, func(current, total int64) {
+		progressed = total == 11
+	})
+	if err != nil {
+		t.Fatal("Copy failed:", err)
+	}
+	if !progressed {
+		t.Error("Expected the progress callback to be called")
+	}
+	if contents, _, err := dst.LoadContents(nil); err != nil || string(contents) != "hello world" {
+		t.Errorf("Unexpected copy contents %q, %v", contents, err)
+	}
+
+	moved := dir.GetChild("c.txt")
+	if err := src.Move(moved, glib.FILE_COPY_NONE, nil, nil); err != nil {
+		t.Fatal("Move failed:", err)
+	}
+	if src.QueryExists(nil) || !moved.QueryExists(nil) {
+		t.Error("Expected a.txt to be moved to c.txt")
+	}
+
+	enumerator, err := dir.EnumerateChildren(glib.FILE_ATTRIBUTE_STANDARD_NAME, glib.FILE_QUERY_INFO_NONE, nil)
+	if err != nil {
+		t.Fatal("EnumerateChildren failed:", err)
+	}
+	var names []string
+	for {
+		info, err := enumerator.NextFile(nil)
+		if err != nil {
+			t.Fatal("NextFile failed:", err)
+		}
+		if info == nil {
+			break
+		}
+		names = append(names, info.GetName())
+		if child := enumerator.GetChild(info); child.GetPath() != filepath.Join(tmp, info.GetName()) {
+			t.Errorf("Unexpected child path %s", child.GetPath())
+		}
+	}
+	enumerator.Close(nil)
+	sort.Strings(names)
+	if len(names) != 2 || names[0] != "c.txt" || names[1] != "sub" {
+		t.Errorf("Unexpected children %v", names)
+	}
+
+	if err := moved.Delete(nil); err != nil {
+		t.Fatal("Delete failed:", err)
+	}
+	if err := moved.Delete(nil); err == nil {
+		t.Error("Expected Delete to fail on a missing file")
+	}
+
+	if rel := dir.GetRelativePath(dst); rel != filepath.Join("sub", "b.txt") {
+		t.Errorf("Unexpected relative path %q", rel)
+	}
+	if uri, _ := glib.FileNewForURI(dst.GetURI()); !uri.Equal(dst) {
+		t.Error("Expected FileNewForURI(GetURI()) to be equal to the file")
+	}
+}
@@ -0,0 +1,332 @@
+package glib
+
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+// #include "gfile.go.h"
+import "C"
+import (
+	"time"
+	"unsafe"
+)
+
+// Attributes that can be queried with File.QueryInfo and
+// File.EnumerateChildren. Whole namespaces can be queried with
+// "namespace::*".
+const (
+	FILE_ATTRIBUTE_STANDARD_TYPE              = "standard::type"
+	FILE_ATTRIBUTE_STANDARD_IS_HIDDEN         = "standard::is-hidden"
+	FILE_ATTRIBUTE_STANDARD_IS_SYMLINK        = "standard::is-symlink"
+	FILE_ATTRIBUTE_STANDARD_NAME              = "standard::name"
+	FILE_ATTRIBUTE_STANDARD_DISPLAY_NAME      = "standard::display-name"
+	FILE_ATTRIBUTE_STANDARD_EDIT_NAME         = "standard::edit-name"
+	FILE_ATTRIBUTE_STANDARD_ICON              = "standard::icon"
+	FILE_ATTRIBUTE_STANDARD_SYMBOLIC_ICON     = "standard::symbolic-icon"
+	FILE_ATTRIBUTE_STANDARD_CONTENT_TYPE      = "standard::content-type"
+	FILE_ATTRIBUTE_STANDARD_FAST_CONTENT_TYPE = "standard::fast-content-type"
+	FILE_ATTRIBUTE_STANDARD_SIZE              = "standard::size"
+	FILE_ATTRIBUTE_STANDARD_SYMLINK_TARGET    = "standard::symlink-target"
+	FILE_ATTRIBUTE_ETAG_VALUE                 = "etag::value"
+	FILE_ATTRIBUTE_ACCESS_CAN_READ            = "access::can-read"
+	FILE_ATTRIBUTE_ACCESS_CAN_WRITE           = "access::can-write"
+	FILE_ATTRIBUTE_ACCESS_CAN_EXECUTE         = "access::can-execute"
+	FILE_ATTRIBUTE_ACCESS_CAN_DELETE          = "access::can-delete"
+	FILE_ATTRIBUTE_ACCESS_CAN_TRASH           = "access::can-trash"
+	FILE_ATTRIBUTE_ACCESS_CAN_RENAME          = "access::can-rename"
+	FILE_ATTRIBUTE_TIME_MODIFIED              = "time::modified"
+	FILE_ATTRIBUTE_TIME_MODIFIED_USEC         = "time::modified-usec"
+	FILE_ATTRIBUTE_TIME_ACCESS                = "time::access"
+	FILE_ATTRIBUTE_TIME_ACCESS_USEC           = "time::access-usec"
+	FILE_ATTRIBUTE_UNIX_MODE                  = "unix::mode"
+)
+
+/*
+ * GFileInfo
+ */
+
+// FileInfo is a representation of GIO's GFileInfo.
+type FileInfo struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GFileInfo.
+func (v *FileInfo) native() *C.GFileInfo {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGFileInfo(p)
+}
+
+// Native returns a pointer to the underlying GFileInfo.
+func (v *FileInfo) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalFileInfo(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapFileInfo(obj), nil
+}
+
+func wrapFileInfo(obj *Object) *FileInfo {
+	if obj == nil {
+		return nil
+	}
+	return &FileInfo{obj}
+}
+
+// FileInfoNew is a wrapper around g_file_info_new().
+func FileInfoNew() *FileInfo {
+	c := C.g_file_info_new()
+	return wrapFileInfo(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// Dup is a wrapper around g_file_info_dup().
+func (v *FileInfo) Dup() *FileInfo {
+	c := C.g_file_info_dup(v.native())
+	return wrapFileInfo(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// HasAttribute is a wrapper around g_file_info_has_attribute().
+func (v *FileInfo) HasAttribute(attribute string) bool {
+	cstr := (*C.char)(C.CString(attribute))
+	defer C.free(unsafe.Pointer(cstr))
+
+	return gobool(C.g_file_info_has_attribute(v.native(), cstr))
+}
+
+// ListAttributes is a wrapper around g_file_info_list_attributes().
+// nameSpace may be empty to list all attributes.
+func (v *FileInfo) ListAttributes(nameSpace string) []string {
+	var cstr *C.char
+	if nameSpace != "" {
+		cstr = C.CString(nameSpace)
+		defer C.free(unsafe.Pointer(cstr))
+	}
+
+	c := (**C.gchar)(unsafe.Pointer(C.g_file_info_list_attributes(v.native(), cstr)))
+	if c == nil {
+		return nil
+	}
+	defer C.g_strfreev(c)
+
+	var attributes []string
+	for p := c; *p != nil; p = C.next_gcharptr(p) {
+		attributes = append(attributes, goString(*p))
+	}
+	return attributes
+}
+
+// GetAttributeString is a wrapper around g_file_info_get_attribute_string().
+func (v *FileInfo) GetAttributeString(attribute string) string {
+	cstr := (*C.char)(C.CString(attribute))
+	defer C.free(unsafe.Pointer(cstr))
+
+	return C.GoString(C.g_file_info_get_attribute_string(v.native(), cstr))
+}
+
+// GetAttributeBoolean is a wrapper around g_file_info_get_attribute_boolean().
+func (v *FileInfo) GetAttributeBoolean(attribute string) bool {
+	cstr := (*C.char)(C.CString(attribute))
+	defer C.free(unsafe.Pointer(cstr))
+
+	return gobool(C.g_file_info_get_attribute_boolean(v.native(), cstr))
+}
+
+// GetAttributeUint32 is a wrapper around g_file_info_get_attribute_uint32().
+func (v *FileInfo) GetAttributeUint32(attribute string) uint32 {
+	cstr := (*C.char)(C.CString(attribute))
+	defer C.free(unsafe.Pointer(cstr))
+
+	return uint32(C.g_file_info_get_attribute_uint32(v.native(), cstr))
+}
+
+// GetAttributeInt32 is a wrapper around g_file_info_get_attribute_int32().
+func (v *FileInfo) GetAttributeInt32(attribute string) int32 {
+	cstr := (*C.char)(C.CString(attribute))
+	defer C.free(unsafe.Pointer(cstr))
+
+	return int32(C.g_file_info_get_attribute_int32(v.native(), cstr))
+}
+
+// GetAttributeUint64 is a wrapper around g_file_info_get_attribute_uint64().
+func (v *FileInfo) GetAttributeUint64(attribute string) uint64 {
+	cstr := (*C.char)(C.CString(attribute))
+	defer C.free(unsafe.Pointer(cstr))
+
+	return uint64(C.g_file_info_get_attribute_uint64(v.native(), cstr))
+}
+
+// GetAttributeInt64 is a wrapper around g_file_info_get_attribute_int64().
+func (v *FileInfo) GetAttributeInt64(attribute string) int64 {
+	cstr := (*C.char)(C.CString(attribute))
+	defer C.free(unsafe.Pointer(cstr))
+
+	return int64(C.g_file_info_get_attribute_int64(v.native(), cstr))
+}
+
+// GetAttributeObject is a wrapper around g_file_info_get_attribute_object().
+func (v *FileInfo) GetAttributeObject(attribute string) *Object {
+	cstr := (*C.char)(C.CString(attribute))
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_file_info_get_attribute_object(v.native(), cstr)
+	if c == nil {
+		return nil
+	}
+	return Take(unsafe.Pointer(c))
+}
+
+// GetName is a wrapper around g_file_info_get_name().
+func (v *FileInfo) GetName() string {
+	return C.GoString(C.g_file_info_get_name(v.native()))
+}
+
+// GetDisplayName is a wrapper around g_file_info_get_display_name().
+func (v *FileInfo) GetDisplayName() string {
+	return C.GoString(C.g_file_info_get_display_name(v.native()))
+}
+
+// GetFileType is a wrapper around g_file_info_get_file_type().
+func (v *FileInfo) GetFileType() FileType {
+	return FileType(C.g_file_info_get_file_type(v.native()))
+}
+
+// GetIsHidden is a wrapper around g_file_info_get_is_hidden().
+func (v *FileInfo) GetIsHidden() bool {
+	return gobool(C.g_file_info_get_is_hidden(v.native()))
+}
+
+// GetIsSymlink is a wrapper around g_file_info_get_is_symlink().
+func (v *FileInfo) GetIsSymlink() bool {
+	return gobool(C.g_file_info_get_is_symlink(v.native()))
+}
+
+// GetSymlinkTarget is a wrapper around g_file_info_get_symlink_target().
+func (v *FileInfo) GetSymlinkTarget() string {
+	return C.GoString(C.g_file_info_get_symlink_target(v.native()))
+}
+
+// GetSize is a wrapper around g_file_info_get_size().
+func (v *FileInfo) GetSize() int64 {
+	return int64(C.g_file_info_get_size(v.native()))
+}
+
+// GetContentType is a wrapper around g_file_info_get_content_type().
+func (v *FileInfo) GetContentType() string {
+	return C.GoString(C.g_file_info_get_content_type(v.native()))
+}
+
+// GetEtag is a wrapper around g_file_info_get_etag().
+func (v *FileInfo) GetEtag() string {
+	return C.GoString(C.g_file_info_get_etag(v.native()))
+}
+
+// GetIcon is a wrapper around g_file_info_get_icon().
+func (v *FileInfo) GetIcon() *Icon {
+	c := C.g_file_info_get_icon(v.native())
+	if c == nil {
+		return nil
+	}
+	return wrapIcon(Take(unsafe.Pointer(c)))
+}
+
+// GetSymbolicIcon is a wrapper around g_file_info_get_symbolic_icon().
+func (v *FileInfo) GetSymbolicIcon() *Icon {
+	c := C.g_file_info_get_symbolic_icon(v.native())
+	if c == nil {
+		return nil
+	}
+	return wrapIcon(Take(unsafe.Pointer(c)))
+}
+
+// GetModificationTime returns the time of the last modification of the
+// file, from the FILE_ATTRIBUTE_TIME_MODIFIED and
+// FILE_ATTRIBUTE_TIME_MODIFIED_USEC attributes. It returns the zero time if
+// they were not queried.
+func (v *FileInfo) GetModificationTime() time.Time {
+	if !v.HasAttribute(FILE_ATTRIBUTE_TIME_MODIFIED) {
+		return time.Time{}
+	}
+	sec := v.GetAttributeUint64(FILE_ATTRIBUTE_TIME_MODIFIED)
+	usec := v.GetAttributeUint32(FILE_ATTRIBUTE_TIME_MODIFIED_USEC)
+	return time.Unix(int64(sec), int64(usec)*int64(time.Microsecond))
+}
+
+/*
+ * GFileEnumerator
+ */
+
+// FileEnumerator is a representation of GIO's GFileEnumerator.
+type FileEnumerator struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GFileEnumerator.
+func (v *FileEnumerator) native() *C.GFileEnumerator {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGFileEnumerator(p)
+}
+
+// Native returns a pointer to the underlying GFileEnumerator.
+func (v *FileEnumerator) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalFileEnumerator(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapFileEnumerator(obj), nil
+}
+
+func wrapFileEnumerator(obj *Object) *FileEnumerator {
+	if obj == nil {
+		return nil
+	}
+	return &FileEnumerator{obj}
+}
+
+// NextFile is a wrapper around g_file_enumerator_next_file(). It returns
+// nil and no error once all files have been enumerated.
+func (v *FileEnumerator) NextFile(cancellable *Cancellable) (*FileInfo, error) {
+	var gerr *C.GError
+	c := C.g_file_enumerator_next_file(v.native(), cancellable.native(), &gerr)
+	if c == nil {
+		if gerr == nil {
+			return nil, nil
+		}
+		return nil, takeError(gerr)
+	}
+	return wrapFileInfo(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// Close is a wrapper around g_file_enumerator_close().
+func (v *FileEnumerator) Close(cancellable *Cancellable) error {
+	var gerr *C.GError
+	if !gobool(C.g_file_enumerator_close(v.native(), cancellable.native(), &gerr)) {
+		return takeError(gerr)
+	}
+	return nil
+}
+
+// IsClosed is a wrapper around g_file_enumerator_is_closed().
+func (v *FileEnumerator) IsClosed() bool {
+	return gobool(C.g_file_enumerator_is_closed(v.native()))
+}
+
+// GetContainer is a wrapper around g_file_enumerator_get_container().
+func (v *FileEnumerator) GetContainer() *File {
+	c := C.g_file_enumerator_get_container(v.native())
+	return wrapFile(Take(unsafe.Pointer(c)))
+}
+
+// GetChild is a wrapper around g_file_enumerator_get_child().
+func (v *FileEnumerator) GetChild(info *FileInfo) *File {
+	c := C.g_file_enumerator_get_child(v.native(), info.native())
+	return wrapFile(AssumeOwnership(unsafe.Pointer(c)))
+}
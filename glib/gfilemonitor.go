@@ -0,0 +1,161 @@
+package glib
+
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+// #include "gfile.go.h"
+import "C"
+import (
+	"unsafe"
+)
+
+func init() {
+	tm := []TypeMarshaler{
+		{Type(C.g_file_monitor_get_type()), marshalFileMonitor},
+
+		// Enums
+		{Type(C.g_file_monitor_event_get_type()), marshalFileMonitorEvent},
+		{Type(C.g_file_monitor_flags_get_type()), marshalFileMonitorFlags},
+	}
+
+	RegisterGValueMarshalers(tm)
+}
+
+// FileMonitorEvent is a representation of GIO's GFileMonitorEvent.
+type FileMonitorEvent int
+
+const (
+	FILE_MONITOR_EVENT_CHANGED           FileMonitorEvent = C.G_FILE_MONITOR_EVENT_CHANGED
+	FILE_MONITOR_EVENT_CHANGES_DONE_HINT FileMonitorEvent = C.G_FILE_MONITOR_EVENT_CHANGES_DONE_HINT
+	FILE_MONITOR_EVENT_DELETED           FileMonitorEvent = C.G_FILE_MONITOR_EVENT_DELETED
+	FILE_MONITOR_EVENT_CREATED           FileMonitorEvent = C.G_FILE_MONITOR_EVENT_CREATED
+	FILE_MONITOR_EVENT_ATTRIBUTE_CHANGED FileMonitorEvent = C.G_FILE_MONITOR_EVENT_ATTRIBUTE_CHANGED
+	FILE_MONITOR_EVENT_PRE_UNMOUNT       FileMonitorEvent = C.G_FILE_MONITOR_EVENT_PRE_UNMOUNT
+	FILE_MONITOR_EVENT_UNMOUNTED         FileMonitorEvent = C.G_FILE_MONITOR_EVENT_UNMOUNTED
+	FILE_MONITOR_EVENT_MOVED             FileMonitorEvent = C.G_FILE_MONITOR_EVENT_MOVED
+)
+
+func marshalFileMonitorEvent(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return FileMonitorEvent(c), nil
+}
+
+// FileMonitorFlags is a representation of GIO's GFileMonitorFlags.
+type FileMonitorFlags int
+
+const (
+	FILE_MONITOR_NONE             FileMonitorFlags = C.G_FILE_MONITOR_NONE
+	FILE_MONITOR_WATCH_MOUNTS     FileMonitorFlags = C.G_FILE_MONITOR_WATCH_MOUNTS
+	FILE_MONITOR_SEND_MOVED       FileMonitorFlags = C.G_FILE_MONITOR_SEND_MOVED
+	FILE_MONITOR_WATCH_HARD_LINKS FileMonitorFlags = C.G_FILE_MONITOR_WATCH_HARD_LINKS
+)
+
+func marshalFileMonitorFlags(p uintptr) (interface{}, error) {
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
+	return FileMonitorFlags(c), nil
+}
+
+/*
+ * GFileMonitor
+ */
+
+// FileMonitor is a representation of GIO's GFileMonitor. Its "changed"
+// signal is emitted in the thread-default main context of the goroutine
+// that created it, see ConnectChanged.
+type FileMonitor struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GFileMonitor.
+func (v *FileMonitor) native() *C.GFileMonitor {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGFileMonitor(p)
+}
+
+// Native returns a pointer to the underlying GFileMonitor.
+func (v *FileMonitor) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalFileMonitor(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapFileMonitor(obj), nil
+}
+
+func wrapFileMonitor(obj *Object) *FileMonitor {
+	if obj == nil {
+		return nil
+	}
+	return &FileMonitor{obj}
+}
+
+// Monitor is a wrapper around g_file_monitor(). It monitors either a file
+// or a directory, depending on the type of the file.
+func (v *File) Monitor(flags FileMonitorFlags, cancellable *Cancellable) (*FileMonitor, error) {
+	var gerr *C.GError
+	c := C.g_file_monitor(v.native(), C.GFileMonitorFlags(flags), cancellable.native(), &gerr)
+	if c == nil {
+		return nil, takeError(gerr)
+	}
+	return wrapFileMonitor(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// MonitorFile is a wrapper around g_file_monitor_file().
+func (v *File) MonitorFile(flags FileMonitorFlags, cancellable *Cancellable) (*FileMonitor, error) {
+	var gerr *C.GError
+	c := C.g_file_monitor_file(v.native(), C.GFileMonitorFlags(flags), cancellable.native(), &gerr)
+	if c == nil {
+		return nil, takeError(gerr)
+	}
+	return wrapFileMonitor(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// MonitorDirectory is a wrapper around g_file_monitor_directory().
+func (v *File) MonitorDirectory(flags FileMonitorFlags, cancellable *Cancellable) (*FileMonitor, error) {
+	var gerr *C.GError
+	c := C.g_file_monitor_directory(v.native(), C.GFileMonitorFlags(flags), cancellable.native(), &gerr)
+	if c == nil {
+		return nil, takeError(gerr)
+	}
+	return wrapFileMonitor(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// Cancel is a wrapper around g_file_monitor_cancel().
+func (v *FileMonitor) Cancel() bool {
+	return gobool(C.g_file_monitor_cancel(v.native()))
+}
+
+// IsCancelled is a wrapper around g_file_monitor_is_cancelled().
+func (v *FileMonitor) IsCancelled() bool {
+	return gobool(C.g_file_monitor_is_cancelled(v.native()))
+}
+
+// SetRateLimit is a wrapper around g_file_monitor_set_rate_limit().
+// limitMsecs is the minimum time between two FILE_MONITOR_EVENT_CHANGED
+// events for the same file.
+func (v *FileMonitor) SetRateLimit(limitMsecs int) {
+	C.g_file_monitor_set_rate_limit(v.native(), C.gint(limitMsecs))
+}
+
+// FileMonitorChangedCallback is the handler of the "changed" signal of a
+// FileMonitor. otherFile is nil unless eventType is
+// FILE_MONITOR_EVENT_MOVED, FILE_MONITOR_EVENT_RENAMED,
+// FILE_MONITOR_EVENT_MOVED_IN or FILE_MONITOR_EVENT_MOVED_OUT.
+type FileMonitorChangedCallback func(monitor *FileMonitor, file, otherFile *File, eventType FileMonitorEvent)
+
+// ConnectChanged connects f to the "changed" signal of the FileMonitor.
+func (v *FileMonitor) ConnectChanged(f FileMonitorChangedCallback) SignalHandle {
+	return v.ConnectMarshal("changed", func(_ *Value, p []Value) {
+		var otherFile *File
+		if obj := p[2].GetObject(); obj != nil {
+			otherFile = wrapFile(obj)
+		}
+		eventType, _ := p[3].GoValue()
+		f(wrapFileMonitor(p[0].GetObject()), wrapFile(p[1].GetObject()), otherFile, eventType.(FileMonitorEvent))
+	})
+}
@@ -0,0 +1,18 @@
+// Same copyright and license as the rest of the files in this project
+
+// +build !glib_2_40,!glib_2_42,!glib_2_44
+
+package glib
+
+// #include <gio/gio.h>
+import "C"
+
+const (
+	FILE_MONITOR_EVENT_RENAMED   FileMonitorEvent = C.G_FILE_MONITOR_EVENT_RENAMED
+	FILE_MONITOR_EVENT_MOVED_IN  FileMonitorEvent = C.G_FILE_MONITOR_EVENT_MOVED_IN
+	FILE_MONITOR_EVENT_MOVED_OUT FileMonitorEvent = C.G_FILE_MONITOR_EVENT_MOVED_OUT
+)
+
+const (
+	FILE_MONITOR_WATCH_MOVES FileMonitorFlags = C.G_FILE_MONITOR_WATCH_MOVES
+)
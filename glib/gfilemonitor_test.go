@@ -0,0 +1,52 @@
+package glib_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func TestFileMonitor(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "gotk3-gfilemonitor")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	dir, _ := glib.FileNewForPath(tmp)
+	monitor, err := dir.MonitorDirectory(glib.FILE_MONITOR_NONE, nil)
+	if err != nil {
+		t.Fatal("MonitorDirectory failed:", err)
+	}
+	defer monitor.Cancel()
+
+	var created string
+	monitor.ConnectChanged(func(_ *glib.FileMonitor, file, otherFile *glib.File, event glib.FileMonitorEvent) {
+		if event == glib.FILE_MONITOR_EVENT_CREATED {
+			created = file.GetBasename()
+		}
+	})
+
+	if err := ioutil.WriteFile(filepath.Join(tmp, "new.txt"), []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := glib.MainContextDefault()
+	for deadline := time.Now().Add(5 * time.Second); created == "" && time.Now().Before(deadline); {
+		if !ctx.Iteration(false) {
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	if created != "new.txt" {
+		t.Errorf("Expected a created event for new.txt, got %q", created)
+	}
+
+	monitor.Cancel()
+	if !monitor.IsCancelled() {
+		t.Error("Expected the monitor to be cancelled")
+	}
+}
@@ -6,7 +6,6 @@ package glib
 // #include "glib.go.h"
 import "C"
 import (
-	"errors"
 	"runtime"
 	"unsafe"
 )
@@ -94,8 +93,7 @@ func IconNewForString(str string) (*Icon, error) {
 	var err *C.GError
 	c := C.g_icon_new_for_string((*C.gchar)(cstr), &err)
 	if c == nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(C.GoString((*C.char)(err.message)))
+		return nil, takeError(err)
 	}
 
 	obj := &Object{ToGObject(unsafe.Pointer(c))}
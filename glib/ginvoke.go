@@ -0,0 +1,92 @@
+package glib
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// States of an invocation.
+const (
+	invocationPending int32 = iota
+	invocationRunning
+	invocationCancelled
+)
+
+// invocation is a function scheduled on the default main context by
+// InvokeSync and InvokeAsync.
+type invocation struct {
+	f        func()
+	state    int32
+	done     chan struct{}
+	panicked bool
+	panicVal interface{}
+}
+
+// invoke schedules f on the default main context. Like
+// g_main_context_invoke(), it is called right away if the calling thread
+// owns the context, so that invoking from the GTK main loop does not
+// deadlock, or if no thread owns it and the calling thread can acquire it.
+func invoke(f func()) *invocation {
+	inv := &invocation{f: f, done: make(chan struct{})}
+	MainContextDefault().Invoke(inv.run)
+	return inv
+}
+
+func (inv *invocation) run() {
+	if !atomic.CompareAndSwapInt32(&inv.state, invocationPending, invocationRunning) {
+		return
+	}
+	defer close(inv.done)
+
+	inv.panicked = true
+	defer func() {
+		if inv.panicked {
+			inv.panicVal = recover()
+		}
+	}()
+	inv.f()
+	inv.panicked = false
+}
+
+// cancel prevents f from being called if it has not started yet.
+func (inv *invocation) cancel() bool {
+	return atomic.CompareAndSwapInt32(&inv.state, invocationPending, invocationCancelled)
+}
+
+// wait waits for f to return, and panics again in the calling goroutine if
+// f panicked.
+func (inv *invocation) wait() {
+	<-inv.done
+	if inv.panicked {
+		panic(inv.panicVal)
+	}
+}
+
+// InvokeAsync calls f in the default main context, which runs the GTK main
+// loop, and waits for it to return. If ctx is done before f is called, f is
+// skipped and ctx.Err() is returned; once f has started, InvokeAsync waits
+// for it to return. A panic in f is propagated to the caller.
+//
+// It may be called from any goroutine. f is called right away on the calling
+// goroutine, like with g_main_context_invoke(), when the calling thread
+// already owns the default main context, as in the main loop itself, and
+// also when no thread owns it, e.g. before gtk.Main is called or after it
+// returns. In the latter case f does not run on the GTK thread, so code
+// calling InvokeAsync before the main loop starts should not rely on it to
+// reach the main thread.
+func InvokeAsync(ctx context.Context, f func()) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	inv := invoke(f)
+	select {
+	case <-inv.done:
+	case <-ctx.Done():
+		if inv.cancel() {
+			return ctx.Err()
+		}
+	}
+	inv.wait()
+	return nil
+}
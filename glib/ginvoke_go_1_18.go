@@ -0,0 +1,23 @@
+//go:build go1.18
+// +build go1.18
+
+package glib
+
+// InvokeSync calls f in the default main context, which runs the GTK main
+// loop, and returns its result once it is done, e.g.
+//
+//	text := glib.InvokeSync(func() string {
+//		text, _ := entry.GetText()
+//		return text
+//	})
+//
+// A panic in f is propagated to the caller. It may be called from any
+// goroutine; like InvokeAsync, it calls f right away on the calling goroutine
+// when the calling thread owns the default main context or no thread owns it,
+// e.g. when the main loop is not running. See InvokeAsync to give up if a
+// context is done first.
+func InvokeSync[T any](f func() T) T {
+	var v T
+	invoke(func() { v = f() }).wait()
+	return v
+}
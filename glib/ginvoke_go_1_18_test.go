@@ -0,0 +1,70 @@
+//go:build go1.18
+// +build go1.18
+
+package glib_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func TestInvoke(t *testing.T) {
+	mainLoop := glib.MainLoopNew(glib.MainContextDefault(), false)
+	release := make(chan struct{})
+
+	var (
+		result    int
+		recovered interface{}
+		cancelErr error
+		cancelRan bool
+		doneRan   bool
+		doneErr   error
+	)
+	go func() {
+		defer glib.IdleAdd(mainLoop.Quit)
+
+		result = glib.InvokeSync(func() int {
+			if !glib.MainContextDefault().IsOwner() {
+				t.Error("Expected InvokeSync to run in the main loop")
+			}
+			return 42
+		})
+
+		func() {
+			defer func() { recovered = recover() }()
+			glib.InvokeSync(func() bool { panic("invoke panic") })
+		}()
+
+		// Block the main loop so that the invocation is still pending when
+		// ctx is cancelled.
+		glib.IdleAdd(func() { <-release })
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		cancelErr = glib.InvokeAsync(ctx, func() { cancelRan = true })
+		close(release)
+
+		doneErr = glib.InvokeAsync(context.Background(), func() { doneRan = true })
+	}()
+	runMainLoop(t, mainLoop)
+
+	if result != 42 {
+		t.Errorf("Expected 42, got %d", result)
+	}
+	if recovered != "invoke panic" {
+		t.Errorf("Expected the panic to be propagated, got %v", recovered)
+	}
+	if cancelErr != context.DeadlineExceeded || cancelRan {
+		t.Errorf("Expected the invocation to be cancelled, got %v", cancelErr)
+	}
+	if doneErr != nil || !doneRan {
+		t.Errorf("Expected the invocation to run, got %v", doneErr)
+	}
+
+	// Without a running loop, the function is called right away.
+	if v := glib.InvokeSync(func() string { return "direct" }); v != "direct" {
+		t.Errorf("Expected direct, got %q", v)
+	}
+}
@@ -7,8 +7,10 @@ package glib
 import "C"
 import (
 	"bytes"
-	"errors"
+	"io"
 	"unsafe"
+
+	"github.com/gotk3/gotk3/internal/callback"
 )
 
 func init() {
@@ -74,9 +76,19 @@ func wrapIOStream(obj *Object) *IOStream {
 	return &IOStream{obj}
 }
 
+// GetInputStream is a wrapper around g_io_stream_get_input_stream().
+func (v *IOStream) GetInputStream() *InputStream {
+	c := C.g_io_stream_get_input_stream(v.native())
+	return wrapInputStream(Take(unsafe.Pointer(c)))
+}
+
+// GetOutputStream is a wrapper around g_io_stream_get_output_stream().
+func (v *IOStream) GetOutputStream() *OutputStream {
+	c := C.g_io_stream_get_output_stream(v.native())
+	return wrapOutputStream(Take(unsafe.Pointer(c)))
+}
+
 /*
-GInputStream * 	g_io_stream_get_input_stream ()
-GOutputStream * 	g_io_stream_get_output_stream ()
 void 	g_io_stream_splice_async ()
 gboolean 	g_io_stream_splice_finish ()
 */
@@ -89,16 +101,19 @@ func (v *IOStream) Close(cancellable *Cancellable) (bool, error) {
 		cancellable.native(),
 		&gerr))
 	if !ok {
-		defer C.g_error_free(gerr)
-		return false, errors.New(goString(gerr.message))
+		return false, takeError(gerr)
 	}
 	return ok, nil
 }
 
+// IsClosed is a wrapper around g_io_stream_is_closed().
+func (v *IOStream) IsClosed() bool {
+	return gobool(C.g_io_stream_is_closed(v.native()))
+}
+
 /*
 void 	g_io_stream_close_async ()
 gboolean 	g_io_stream_close_finish ()
-gboolean 	g_io_stream_is_closed ()
 gboolean 	g_io_stream_has_pending ()
 gboolean 	g_io_stream_set_pending ()
 void 	g_io_stream_clear_pending ()
@@ -159,12 +174,47 @@ func (v *InputStream) Read(length uint, cancellable *Cancellable) (*bytes.Buffer
 		cancellable.native(),
 		&gerr)
 	if c == -1 {
-		defer C.g_error_free(gerr)
-		return nil, -1, errors.New(goString(gerr.message))
+		return nil, -1, takeError(gerr)
 	}
 	return buffer, int(c), nil
 }
 
+// AsReader returns an io.ReadCloser reading from the stream, for use with
+// the io package. cancellable may be nil, and is used for every Read and
+// Close.
+func (v *InputStream) AsReader(cancellable *Cancellable) io.ReadCloser {
+	return &inputStreamReader{v, cancellable}
+}
+
+// inputStreamReader is returned by InputStream.AsReader. InputStream itself
+// cannot satisfy io.Reader, as its Read and Close methods predate it.
+type inputStreamReader struct {
+	stream      *InputStream
+	cancellable *Cancellable
+}
+
+func (r *inputStreamReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	var gerr *C.GError
+	c := C.g_input_stream_read(r.stream.native(), unsafe.Pointer(&p[0]), C.gsize(len(p)),
+		r.cancellable.native(), &gerr)
+	if c == -1 {
+		return 0, takeError(gerr)
+	}
+	if c == 0 {
+		return 0, io.EOF
+	}
+	return int(c), nil
+}
+
+func (r *inputStreamReader) Close() error {
+	_, err := r.stream.Close(r.cancellable)
+	return err
+}
+
 // TODO find a way to get size to be read without asking for ...
 /*
 gboolean
@@ -190,8 +240,75 @@ func (v *InputStream) Close(cancellable *Cancellable) (bool, error) {
 		cancellable.native(),
 		&gerr))
 	if !ok {
-		defer C.g_error_free(gerr)
-		return false, errors.New(goString(gerr.message))
+		return false, takeError(gerr)
+	}
+	return ok, nil
+}
+
+// ReadBytesAsync is a wrapper around g_input_stream_read_bytes_async().
+// Unlike g_input_stream_read_async(), the buffer is allocated by GIO, the
+// data read is returned by ReadBytesFinish.
+func (v *InputStream) ReadBytesAsync(count uint, ioPriority Priority, cancellable *Cancellable, fn AsyncReadyCallback) {
+	C._g_input_stream_read_bytes_async(v.native(), C.gsize(count), C.int(ioPriority), cancellable.native(),
+		C.gpointer(callback.Assign(fn)))
+}
+
+// ReadBytesFinish is a wrapper around g_input_stream_read_bytes_finish().
+// An empty slice is returned at the end of the stream.
+func (v *InputStream) ReadBytesFinish(result *AsyncResult) ([]byte, error) {
+	var gerr *C.GError
+	c := C.g_input_stream_read_bytes_finish(v.native(), result.native(), &gerr)
+	if c == nil {
+		return nil, takeError(gerr)
+	}
+	defer C.g_bytes_unref(c)
+	return goBytes(c), nil
+}
+
+// goBytes returns a copy of the data of a GBytes.
+func goBytes(b *C.GBytes) []byte {
+	var size C.gsize
+	p := C.g_bytes_get_data(b, &size)
+	return goByteSlice(unsafe.Pointer(p), size)
+}
+
+// newGBytes returns a new GBytes holding a copy of data.
+func newGBytes(data []byte) *C.GBytes {
+	var p C.gconstpointer
+	if len(data) > 0 {
+		p = C.gconstpointer(unsafe.Pointer(&data[0]))
+	}
+	return C.g_bytes_new(p, C.gsize(len(data)))
+}
+
+// SkipAsync is a wrapper around g_input_stream_skip_async().
+func (v *InputStream) SkipAsync(count uint, ioPriority Priority, cancellable *Cancellable, fn AsyncReadyCallback) {
+	C._g_input_stream_skip_async(v.native(), C.gsize(count), C.int(ioPriority), cancellable.native(),
+		C.gpointer(callback.Assign(fn)))
+}
+
+// SkipFinish is a wrapper around g_input_stream_skip_finish().
+func (v *InputStream) SkipFinish(result *AsyncResult) (int, error) {
+	var gerr *C.GError
+	c := C.g_input_stream_skip_finish(v.native(), result.native(), &gerr)
+	if c == -1 {
+		return -1, takeError(gerr)
+	}
+	return int(c), nil
+}
+
+// CloseAsync is a wrapper around g_input_stream_close_async().
+func (v *InputStream) CloseAsync(ioPriority Priority, cancellable *Cancellable, fn AsyncReadyCallback) {
+	C._g_input_stream_close_async(v.native(), C.int(ioPriority), cancellable.native(),
+		C.gpointer(callback.Assign(fn)))
+}
+
+// CloseFinish is a wrapper around g_input_stream_close_finish().
+func (v *InputStream) CloseFinish(result *AsyncResult) (bool, error) {
+	var gerr *C.GError
+	ok := gobool(C.g_input_stream_close_finish(v.native(), result.native(), &gerr))
+	if !ok {
+		return false, takeError(gerr)
 	}
 	return ok, nil
 }
@@ -200,10 +317,6 @@ func (v *InputStream) Close(cancellable *Cancellable) (bool, error) {
 /*
 void 	g_input_stream_read_async ()
 gssize 	g_input_stream_read_finish ()
-void 	g_input_stream_skip_async ()
-gssize 	g_input_stream_skip_finish ()
-void 	g_input_stream_close_async ()
-gboolean 	g_input_stream_close_finish ()
 */
 
 // IsClosed is a wrapper around g_input_stream_is_closed().
@@ -223,8 +336,7 @@ func (v *InputStream) SetPending() (bool, error) {
 		v.native(),
 		&gerr))
 	if !ok {
-		defer C.g_error_free(gerr)
-		return false, errors.New(goString(gerr.message))
+		return false, takeError(gerr)
 	}
 	return ok, nil
 }
@@ -238,8 +350,6 @@ func (v *InputStream) ClearPending() {
    implementation of (*InputStream).Read that do same thing.
 
 GBytes * 	g_input_stream_read_bytes ()
-void 	g_input_stream_read_bytes_async ()
-GBytes * 	g_input_stream_read_bytes_finish ()
 */
 
 /*
@@ -307,8 +417,7 @@ func (v *OutputStream) Write(buffer *bytes.Buffer, cancellable *Cancellable) (in
 		cancellable.native(),
 		&gerr)
 	if c == -1 {
-		defer C.g_error_free(gerr)
-		return -1, errors.New(goString(gerr.message))
+		return -1, takeError(gerr)
 	}
 	return int(c), nil
 }
@@ -325,15 +434,47 @@ func (v *OutputStream) Write(buffer *bytes.Buffer, cancellable *Cancellable) (in
 // 		cancellable.native(),
 // 		&gerr)
 // 	if c == -1 {
-// 		defer C.g_error_free(gerr)
-// 		return 0, errors.New(goString(gerr.message))
+// 		return 0, takeError(gerr)
 // 	}
 // 	return int(c), nil
 // }
 
-/*
-gboolean 	g_output_stream_write_all ()
-*/
+// AsWriter returns an io.WriteCloser writing to the stream, for use with
+// the io package. cancellable may be nil, and is used for every Write and
+// Close.
+func (v *OutputStream) AsWriter(cancellable *Cancellable) io.WriteCloser {
+	return &outputStreamWriter{v, cancellable}
+}
+
+// outputStreamWriter is returned by OutputStream.AsWriter. OutputStream
+// itself cannot satisfy io.Writer, as its Write and Close methods predate
+// it.
+type outputStreamWriter struct {
+	stream      *OutputStream
+	cancellable *Cancellable
+}
+
+func (w *outputStreamWriter) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	var (
+		written C.gsize
+		gerr    *C.GError
+	)
+	c := C.g_output_stream_write_all(w.stream.native(), unsafe.Pointer(&p[0]), C.gsize(len(p)), &written,
+		w.cancellable.native(), &gerr)
+	if !gobool(c) {
+		return int(written), takeError(gerr)
+	}
+	return int(written), nil
+}
+
+func (w *outputStreamWriter) Close() error {
+	_, err := w.stream.Close(w.cancellable)
+	return err
+}
 
 // TODO outputStream asynch functions
 /*
@@ -363,8 +504,7 @@ func (v *OutputStream) Flush(cancellable *Cancellable) (bool, error) {
 		cancellable.native(),
 		&gerr))
 	if !ok {
-		defer C.g_error_free(gerr)
-		return false, errors.New(goString(gerr.message))
+		return false, takeError(gerr)
 	}
 	return ok, nil
 }
@@ -377,8 +517,77 @@ func (v *OutputStream) Close(cancellable *Cancellable) (bool, error) {
 		cancellable.native(),
 		&gerr))
 	if !ok {
-		defer C.g_error_free(gerr)
-		return false, errors.New(goString(gerr.message))
+		return false, takeError(gerr)
+	}
+	return ok, nil
+}
+
+// WriteBytesAsync is a wrapper around g_output_stream_write_bytes_async().
+// data is copied, so it may be modified once WriteBytesAsync returns.
+func (v *OutputStream) WriteBytesAsync(data []byte, ioPriority Priority, cancellable *Cancellable, fn AsyncReadyCallback) {
+	b := newGBytes(data)
+	defer C.g_bytes_unref(b)
+
+	C._g_output_stream_write_bytes_async(v.native(), b, C.int(ioPriority), cancellable.native(),
+		C.gpointer(callback.Assign(fn)))
+}
+
+// WriteBytesFinish is a wrapper around g_output_stream_write_bytes_finish().
+func (v *OutputStream) WriteBytesFinish(result *AsyncResult) (int, error) {
+	var gerr *C.GError
+	c := C.g_output_stream_write_bytes_finish(v.native(), result.native(), &gerr)
+	if c == -1 {
+		return -1, takeError(gerr)
+	}
+	return int(c), nil
+}
+
+// SpliceAsync is a wrapper around g_output_stream_splice_async().
+func (v *OutputStream) SpliceAsync(source *InputStream, flags OutputStreamSpliceFlags, ioPriority Priority,
+	cancellable *Cancellable, fn AsyncReadyCallback) {
+
+	C._g_output_stream_splice_async(v.native(), source.native(), C.GOutputStreamSpliceFlags(flags),
+		C.int(ioPriority), cancellable.native(), C.gpointer(callback.Assign(fn)))
+}
+
+// SpliceFinish is a wrapper around g_output_stream_splice_finish().
+func (v *OutputStream) SpliceFinish(result *AsyncResult) (int, error) {
+	var gerr *C.GError
+	c := C.g_output_stream_splice_finish(v.native(), result.native(), &gerr)
+	if c == -1 {
+		return -1, takeError(gerr)
+	}
+	return int(c), nil
+}
+
+// FlushAsync is a wrapper around g_output_stream_flush_async().
+func (v *OutputStream) FlushAsync(ioPriority Priority, cancellable *Cancellable, fn AsyncReadyCallback) {
+	C._g_output_stream_flush_async(v.native(), C.int(ioPriority), cancellable.native(),
+		C.gpointer(callback.Assign(fn)))
+}
+
+// FlushFinish is a wrapper around g_output_stream_flush_finish().
+func (v *OutputStream) FlushFinish(result *AsyncResult) (bool, error) {
+	var gerr *C.GError
+	ok := gobool(C.g_output_stream_flush_finish(v.native(), result.native(), &gerr))
+	if !ok {
+		return false, takeError(gerr)
+	}
+	return ok, nil
+}
+
+// CloseAsync is a wrapper around g_output_stream_close_async().
+func (v *OutputStream) CloseAsync(ioPriority Priority, cancellable *Cancellable, fn AsyncReadyCallback) {
+	C._g_output_stream_close_async(v.native(), C.int(ioPriority), cancellable.native(),
+		C.gpointer(callback.Assign(fn)))
+}
+
+// CloseFinish is a wrapper around g_output_stream_close_finish().
+func (v *OutputStream) CloseFinish(result *AsyncResult) (bool, error) {
+	var gerr *C.GError
+	ok := gobool(C.g_output_stream_close_finish(v.native(), result.native(), &gerr))
+	if !ok {
+		return false, takeError(gerr)
 	}
 	return ok, nil
 }
@@ -387,12 +596,6 @@ func (v *OutputStream) Close(cancellable *Cancellable) (bool, error) {
 /*
 void 	g_output_stream_write_async ()
 gssize 	g_output_stream_write_finish ()
-void 	g_output_stream_splice_async ()
-gssize 	g_output_stream_splice_finish ()
-void 	g_output_stream_flush_async ()
-gboolean 	g_output_stream_flush_finish ()
-void 	g_output_stream_close_async ()
-gboolean 	g_output_stream_close_finish ()
 */
 
 // IsClosing is a wrapper around g_output_stream_is_closing().
@@ -417,8 +620,7 @@ func (v *OutputStream) SetPending() (bool, error) {
 		v.native(),
 		&gerr))
 	if !ok {
-		defer C.g_error_free(gerr)
-		return false, errors.New(goString(gerr.message))
+		return false, takeError(gerr)
 	}
 	return ok, nil
 }
@@ -430,8 +632,6 @@ func (v *OutputStream) ClearPending() {
 
 /*
 gssize 	g_output_stream_write_bytes ()
-void 	g_output_stream_write_bytes_async ()
-gssize 	g_output_stream_write_bytes_finish ()
 gboolean 	g_output_stream_printf ()
 gboolean 	g_output_stream_vprintf ()
 */
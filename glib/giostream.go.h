@@ -12,3 +12,131 @@ static GIOStream *toGIOStream(void *p) { return (G_IO_STREAM(p)); }
 static GInputStream *toGInputStream(void *p) { return (G_INPUT_STREAM(p)); }
 
 static GOutputStream *toGOutputStream(void *p) { return (G_OUTPUT_STREAM(p)); }
+
+static GMemoryInputStream *toGMemoryInputStream(void *p) {
+  return (G_MEMORY_INPUT_STREAM(p));
+}
+
+static GMemoryOutputStream *toGMemoryOutputStream(void *p) {
+  return (G_MEMORY_OUTPUT_STREAM(p));
+}
+
+static GFilterInputStream *toGFilterInputStream(void *p) {
+  return (G_FILTER_INPUT_STREAM(p));
+}
+
+static GFilterOutputStream *toGFilterOutputStream(void *p) {
+  return (G_FILTER_OUTPUT_STREAM(p));
+}
+
+static GBufferedInputStream *toGBufferedInputStream(void *p) {
+  return (G_BUFFERED_INPUT_STREAM(p));
+}
+
+static GDataInputStream *toGDataInputStream(void *p) {
+  return (G_DATA_INPUT_STREAM(p));
+}
+
+static GDataOutputStream *toGDataOutputStream(void *p) {
+  return (G_DATA_OUTPUT_STREAM(p));
+}
+
+static GConverter *toGConverter(void *p) { return (G_CONVERTER(p)); }
+
+static GZlibCompressor *toGZlibCompressor(void *p) {
+  return (G_ZLIB_COMPRESSOR(p));
+}
+
+static GZlibDecompressor *toGZlibDecompressor(void *p) {
+  return (G_ZLIB_DECOMPRESSOR(p));
+}
+
+static GCharsetConverter *toGCharsetConverter(void *p) {
+  return (G_CHARSET_CONVERTER(p));
+}
+
+static GConverterInputStream *toGConverterInputStream(void *p) {
+  return (G_CONVERTER_INPUT_STREAM(p));
+}
+
+static GConverterOutputStream *toGConverterOutputStream(void *p) {
+  return (G_CONVERTER_OUTPUT_STREAM(p));
+}
+
+/*
+ * GAsyncReadyCallback
+ */
+
+extern void goAsyncReadyCallbacks(GObject *source_object, GAsyncResult *res,
+                                  gpointer user_data);
+
+static inline void _g_input_stream_read_bytes_async(GInputStream *stream,
+                                                    gsize count,
+                                                    int io_priority,
+                                                    GCancellable *cancellable,
+                                                    gpointer user_data) {
+  g_input_stream_read_bytes_async(stream, count, io_priority, cancellable,
+                                  (GAsyncReadyCallback)(goAsyncReadyCallbacks),
+                                  user_data);
+}
+
+static inline void _g_input_stream_skip_async(GInputStream *stream,
+                                              gsize count, int io_priority,
+                                              GCancellable *cancellable,
+                                              gpointer user_data) {
+  g_input_stream_skip_async(stream, count, io_priority, cancellable,
+                            (GAsyncReadyCallback)(goAsyncReadyCallbacks),
+                            user_data);
+}
+
+static inline void _g_input_stream_close_async(GInputStream *stream,
+                                               int io_priority,
+                                               GCancellable *cancellable,
+                                               gpointer user_data) {
+  g_input_stream_close_async(stream, io_priority, cancellable,
+                             (GAsyncReadyCallback)(goAsyncReadyCallbacks),
+                             user_data);
+}
+
+static inline void _g_output_stream_write_bytes_async(
+    GOutputStream *stream, GBytes *bytes, int io_priority,
+    GCancellable *cancellable, gpointer user_data) {
+  g_output_stream_write_bytes_async(
+      stream, bytes, io_priority, cancellable,
+      (GAsyncReadyCallback)(goAsyncReadyCallbacks), user_data);
+}
+
+static inline void _g_output_stream_splice_async(
+    GOutputStream *stream, GInputStream *source,
+    GOutputStreamSpliceFlags flags, int io_priority,
+    GCancellable *cancellable, gpointer user_data) {
+  g_output_stream_splice_async(stream, source, flags, io_priority, cancellable,
+                               (GAsyncReadyCallback)(goAsyncReadyCallbacks),
+                               user_data);
+}
+
+static inline void _g_output_stream_flush_async(GOutputStream *stream,
+                                                int io_priority,
+                                                GCancellable *cancellable,
+                                                gpointer user_data) {
+  g_output_stream_flush_async(stream, io_priority, cancellable,
+                              (GAsyncReadyCallback)(goAsyncReadyCallbacks),
+                              user_data);
+}
+
+static inline void _g_output_stream_close_async(GOutputStream *stream,
+                                                int io_priority,
+                                                GCancellable *cancellable,
+                                                gpointer user_data) {
+  g_output_stream_close_async(stream, io_priority, cancellable,
+                              (GAsyncReadyCallback)(goAsyncReadyCallbacks),
+                              user_data);
+}
+
+static inline void _g_data_input_stream_read_line_async(
+    GDataInputStream *stream, int io_priority, GCancellable *cancellable,
+    gpointer user_data) {
+  g_data_input_stream_read_line_async(
+      stream, io_priority, cancellable,
+      (GAsyncReadyCallback)(goAsyncReadyCallbacks), user_data);
+}
@@ -194,6 +194,12 @@ func goMarshal(
 		return
 	}
 
+	// Typed handlers convert their parameters themselves, skip reflection.
+	if m, ok := fs.Func.Interface().(ClosureMarshal); ok {
+		goMarshalDirect(fs, m, retValue, int(nParams), params)
+		return
+	}
+
 	fsType := fs.Func.Type()
 
 	// Get number of parameters passed in.
@@ -287,6 +293,24 @@ func goMarshal(
 	}
 }
 
+// goMarshalDirect invokes a ClosureMarshal for goMarshal.
+func goMarshalDirect(fs closure.FuncStack, m ClosureMarshal, retValue *C.GValue, nParams int, params *C.GValue) {
+	defer fs.TryRepanic()
+
+	gValues := gValueSlice(params, nParams)
+	values := make([]Value, nParams)
+	for i := range gValues {
+		values[i] = Value{&gValues[i]}
+	}
+
+	var ret *Value
+	if retValue != nil && gobool(C._g_is_value(retValue)) {
+		ret = &Value{retValue}
+	}
+
+	m(ret, values)
+}
+
 // gValueSlice converts a C array of GValues to a Go slice.
 func gValueSlice(values *C.GValue, nValues int) (slice []C.GValue) {
 	header := (*reflect.SliceHeader)((unsafe.Pointer(&slice)))
@@ -727,45 +751,63 @@ func (v *Object) SetProperty(name string, value interface{}) error {
 // returns an interface{} which must be type asserted as the Go
 // equivalent type to the return value for native C callback.
 //
-// Note that this code is unsafe in that the types of values in args are
-// not checked against whether they are suitable for the callback.
+// The signal name may carry a detail, as in "notify::label". The number and
+// types of args are checked against the signature the signal was declared
+// with, converting values where GLib knows how to (e.g. int to uint). If the
+// signal has a return value, retType must be compatible with it; for signals
+// without a return value retType is ignored.
 func (v *Object) Emit(s string, retType Type, args ...interface{}) (interface{}, error) {
-	cstr := C.CString(s)
-	defer C.free(unsafe.Pointer(cstr))
+	t := v.TypeFromInstance()
+
+	id, detail, sig, err := lookupSignal(s, t)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(args) != len(sig.paramTypes) {
+		return nil, fmt.Errorf("signal %s takes %d arguments, got %d", s, len(sig.paramTypes), len(args))
+	}
+
+	if sig.returnType != TYPE_NONE && retType != TYPE_NONE && !retType.IsA(sig.returnType) && !sig.returnType.IsA(retType) {
+		return nil, fmt.Errorf("signal %s returns %s, not %s", s, sig.returnType.Name(), retType.Name())
+	}
 
 	// Create array of this instance and arguments
 	valv := C.alloc_gvalue_list(C.int(len(args)) + 1)
 	defer C.free(unsafe.Pointer(valv))
 
+	// The Values are copied into valv by value, so they must stay alive
+	// until the emission is over.
+	vals := make([]*Value, 0, len(args)+1)
+
 	// Add args and valv
 	val, err := GValue(v)
 	if err != nil {
 		return nil, errors.New("Error converting Object to GValue: " + err.Error())
 	}
+	vals = append(vals, val)
 	C.val_list_insert(valv, C.int(0), val.native())
 	for i := range args {
-		val, err := GValue(args[i])
+		val, err := signalArg(args[i], sig.paramTypes[i])
 		if err != nil {
 			return nil, fmt.Errorf("Error converting arg %d to GValue: %s", i, err.Error())
 		}
+		vals = append(vals, val)
 		C.val_list_insert(valv, C.int(i+1), val.native())
 	}
 
-	t := v.TypeFromInstance()
-	// TODO: use just the signal name
-	id := C.g_signal_lookup((*C.gchar)(cstr), C.GType(t))
-
 	var ret *Value
-	if retType == TYPE_NONE {
+	if sig.returnType == TYPE_NONE {
 		ret, err = ValueAlloc()
 	} else {
-		ret, err = ValueInit(retType)
+		ret, err = ValueInit(sig.returnType)
 	}
 
 	if err != nil {
 		return nil, errors.New("Error creating Value for return value")
 	}
-	C.g_signal_emitv(valv, id, C.GQuark(0), ret.native())
+	C.g_signal_emitv(valv, id, detail, ret.native())
+	runtime.KeepAlive(vals)
 
 	return ret.GoValue()
 }
@@ -1328,6 +1370,14 @@ func (v *Value) GetPointer() unsafe.Pointer {
 	return unsafe.Pointer(C.g_value_get_pointer(v.native()))
 }
 
+// GetObject is a wrapper around g_value_get_object(). The returned Object
+// holds its own reference, as with Take. It returns nil if the Value holds no
+// object.
+func (v *Value) GetObject() *Object {
+	c := C.g_value_get_object(v.native())
+	return Take(unsafe.Pointer(c))
+}
+
 // GetString is a wrapper around g_value_get_string().  GetString()
 // returns a non-nil error if g_value_get_string() returned a NULL
 // pointer to distinguish between returning a NULL pointer and returning
@@ -16,6 +16,9 @@ func goAsyncReadyCallbacks(sourceObject *C.GObject, res *C.GAsyncResult, userDat
 		source = wrapObject(unsafe.Pointer(sourceObject))
 	}
 
+	// Async operations complete exactly once.
+	defer callback.Delete(uintptr(userData))
+
 	fn := callback.Get(uintptr(userData)).(AsyncReadyCallback)
 	fn(source, wrapAsyncResult(wrapObject(unsafe.Pointer(res))))
 }
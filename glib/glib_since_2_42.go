@@ -11,6 +11,15 @@ package glib
 import "C"
 import "unsafe"
 
+/*
+ * GParamSpec
+ */
+
+// PARAM_EXPLICIT_NOTIFY is a representation of G_PARAM_EXPLICIT_NOTIFY. It
+// keeps "notify" from being emitted automatically when the property is set;
+// the owner then calls Object.Notify itself when the value actually changes.
+const PARAM_EXPLICIT_NOTIFY ParamFlags = C.G_PARAM_EXPLICIT_NOTIFY
+
 /*
  * Notification
  */
@@ -48,3 +57,23 @@ func (v *Application) SetResourceBasePath(bp string) {
 
 	C.g_application_set_resource_base_path(v.native(), cstr1)
 }
+
+// AddMainOption is a wrapper around g_application_add_main_option(). It is
+// like AddMainOptionEntries, for a single option. shortName may be 0 for an
+// option without short name.
+func (v *Application) AddMainOption(longName string, shortName byte, flags OptionFlags, arg OptionArg,
+	description, argDescription string) {
+	cname := (*C.char)(C.CString(longName))
+	defer C.free(unsafe.Pointer(cname))
+	cdesc := (*C.char)(C.CString(description))
+	defer C.free(unsafe.Pointer(cdesc))
+
+	var cargDesc *C.char
+	if argDescription != "" {
+		cargDesc = (*C.char)(C.CString(argDescription))
+		defer C.free(unsafe.Pointer(cargDesc))
+	}
+
+	C.g_application_add_main_option(v.native(), cname, C.char(shortName), C.GOptionFlags(flags),
+		C.GOptionArg(arg), cdesc, cargDesc)
+}
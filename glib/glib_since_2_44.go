@@ -28,3 +28,17 @@ func (v *Application) GetIsBusy() bool {
 func (v *SimpleAction) SetStateHint(stateHint *Variant) {
 	C.g_simple_action_set_state_hint(v.native(), stateHint.native())
 }
+
+/*
+ * SettingsSchema
+ */
+
+// ListChildren is a wrapper around g_settings_schema_list_children().
+func (v *SettingsSchema) ListChildren() []string {
+	return toGoStringArray(C.g_settings_schema_list_children(v.native()))
+}
+
+// GetName is a wrapper around g_settings_schema_key_get_name().
+func (v *SettingsSchemaKey) GetName() string {
+	return C.GoString((*C.char)(C.g_settings_schema_key_get_name(v.native())))
+}
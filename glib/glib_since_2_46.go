@@ -21,3 +21,12 @@ import "github.com/gotk3/gotk3/internal/callback"
 func (v *ListStore) Sort(compareFunc CompareDataFunc) {
 	C._g_list_store_sort(v.native(), C.gpointer(callback.Assign(compareFunc)))
 }
+
+/*
+ * SettingsSchema
+ */
+
+// ListKeys is a wrapper around g_settings_schema_list_keys().
+func (v *SettingsSchema) ListKeys() []string {
+	return toGoStringArray(C.g_settings_schema_list_keys(v.native()))
+}
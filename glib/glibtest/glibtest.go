@@ -0,0 +1,58 @@
+// Same copyright and license as the rest of the files in this project
+
+// Package glibtest provides helpers for testing code using the glib
+// package.
+package glibtest
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+// NewSchemaSource compiles schemas with glib.SettingsSchemaSourceCompile
+// and returns the resulting source. The test is skipped if
+// glib-compile-schemas is not available, and fails if the schemas do not
+// compile.
+func NewSchemaSource(t testing.TB, schemas map[string]string) *glib.SettingsSchemaSource {
+	t.Helper()
+
+	source, err := glib.SettingsSchemaSourceCompile(schemas)
+	if errors.Is(err, exec.ErrNotFound) {
+		t.Skip("glib-compile-schemas not available")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return source
+}
+
+// NewSettings compiles schemaXML, a schemalist document, and returns
+// Settings for its schema schemaID. The Settings are stored in memory with
+// glib.MemorySettingsBackendNew, so that every call starts from the default
+// values and tests do not touch the settings of the user:
+//
+//	settings := glibtest.NewSettings(t, schemaXML, "org.example.app")
+//	prefs := NewPreferencesDialog(settings)
+//
+// A relocatable schema gets a path derived from its id, like
+// /org/example/app/. The test is skipped if glib-compile-schemas is not
+// available.
+func NewSettings(t testing.TB, schemaXML, schemaID string) *glib.Settings {
+	t.Helper()
+
+	source := NewSchemaSource(t, map[string]string{schemaID + ".gschema.xml": schemaXML})
+	schema := source.Lookup(schemaID, false)
+	if schema == nil {
+		t.Fatalf("schema %s not found", schemaID)
+	}
+
+	path := schema.GetPath()
+	if path == "" {
+		path = "/" + strings.Replace(schemaID, ".", "/", -1) + "/"
+	}
+	return glib.SettingsNewFull(schema, glib.MemorySettingsBackendNew(), path)
+}
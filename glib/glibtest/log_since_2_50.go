@@ -0,0 +1,35 @@
+// Same copyright and license as the rest of the files in this project
+
+// +build !glib_2_40,!glib_2_42,!glib_2_44,!glib_2_46,!glib_2_48
+
+package glibtest
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+// FailOnLog makes the test fail on the GLib and GTK log messages of the
+// levels in mask, e.g. glib.LOG_LEVEL_CRITICAL|glib.LOG_LEVEL_WARNING. The
+// messages are recorded with a glib.LogCollector and reported with their
+// stack traces when the test ends, or earlier by calling the returned
+// function. The log writer is reset when the test ends.
+func FailOnLog(t testing.TB, mask glib.LogLevelFlags) (check func()) {
+	t.Helper()
+
+	c := glib.LogCollectorNew(mask, nil)
+	glib.SetLogWriter(c.Writer())
+
+	check = func() {
+		t.Helper()
+		for _, m := range c.Take() {
+			t.Errorf("unexpected log message: %s", m)
+		}
+	}
+	t.Cleanup(func() {
+		glib.SetLogWriter(nil)
+		check()
+	})
+	return check
+}
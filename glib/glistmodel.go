@@ -60,6 +60,12 @@ func wrapListModel(obj *Object) *ListModel {
 	return &ListModel{obj}
 }
 
+// ToListModel is a helper getter, e.g.: it returns a *glib.ListStore as a
+// *glib.ListModel.
+func (v *ListModel) ToListModel() *ListModel {
+	return v
+}
+
 // GetItemType is a wrapper around g_list_model_get_item_type().
 func (v *ListModel) GetItemType() Type {
 	return Type(C.g_list_model_get_item_type(v.native()))
@@ -0,0 +1,85 @@
+package glib
+
+// #include <stdlib.h>
+// #include <glib.h>
+// #include "glog.go.h"
+import "C"
+import (
+	"strconv"
+	"unsafe"
+)
+
+/*
+ * GLogLevelFlags
+ */
+
+// LogLevelFlags is a representation of GLib's GLogLevelFlags.
+type LogLevelFlags int
+
+const (
+	LOG_FLAG_RECURSION LogLevelFlags = C.G_LOG_FLAG_RECURSION
+	LOG_FLAG_FATAL     LogLevelFlags = C.G_LOG_FLAG_FATAL
+
+	LOG_LEVEL_ERROR    LogLevelFlags = C.G_LOG_LEVEL_ERROR
+	LOG_LEVEL_CRITICAL LogLevelFlags = C.G_LOG_LEVEL_CRITICAL
+	LOG_LEVEL_WARNING  LogLevelFlags = C.G_LOG_LEVEL_WARNING
+	LOG_LEVEL_MESSAGE  LogLevelFlags = C.G_LOG_LEVEL_MESSAGE
+	LOG_LEVEL_INFO     LogLevelFlags = C.G_LOG_LEVEL_INFO
+	LOG_LEVEL_DEBUG    LogLevelFlags = C.G_LOG_LEVEL_DEBUG
+
+	LOG_LEVEL_MASK LogLevelFlags = C.G_LOG_LEVEL_MASK
+)
+
+// String returns the name of the most severe level of l, as printed by
+// GLib in front of the messages, e.g. "CRITICAL".
+func (l LogLevelFlags) String() string {
+	switch {
+	case l&LOG_LEVEL_ERROR != 0:
+		return "ERROR"
+	case l&LOG_LEVEL_CRITICAL != 0:
+		return "CRITICAL"
+	case l&LOG_LEVEL_WARNING != 0:
+		return "WARNING"
+	case l&LOG_LEVEL_MESSAGE != 0:
+		return "Message"
+	case l&LOG_LEVEL_INFO != 0:
+		return "INFO"
+	case l&LOG_LEVEL_DEBUG != 0:
+		return "DEBUG"
+	}
+	return "LOG-" + strconv.Itoa(int(l))
+}
+
+// LogSetAlwaysFatal is a wrapper around g_log_set_always_fatal(). The
+// messages of the levels in fatalMask abort the program, for all domains,
+// e.g. LogSetAlwaysFatal(LOG_LEVEL_CRITICAL) turns GTK criticals into
+// crashes. LOG_LEVEL_ERROR is always fatal. The previous mask is returned.
+func LogSetAlwaysFatal(fatalMask LogLevelFlags) LogLevelFlags {
+	c := C.g_log_set_always_fatal(C.GLogLevelFlags(fatalMask))
+	return LogLevelFlags(c)
+}
+
+// LogSetFatalMask is a wrapper around g_log_set_fatal_mask(). It is like
+// LogSetAlwaysFatal, for the messages of the given domain only, e.g. "Gtk".
+// The previous mask of the domain is returned.
+func LogSetFatalMask(domain string, fatalMask LogLevelFlags) LogLevelFlags {
+	cstr := (*C.gchar)(C.CString(domain))
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_log_set_fatal_mask(cstr, C.GLogLevelFlags(fatalMask))
+	return LogLevelFlags(c)
+}
+
+// Log is a wrapper around g_log(). An empty domain is the default one of
+// the application.
+func Log(domain string, level LogLevelFlags, message string) {
+	var cdomain *C.gchar
+	if domain != "" {
+		cdomain = (*C.gchar)(C.CString(domain))
+		defer C.free(unsafe.Pointer(cdomain))
+	}
+	cstr := (*C.gchar)(C.CString(message))
+	defer C.free(unsafe.Pointer(cstr))
+
+	C._g_log(cdomain, C.GLogLevelFlags(level), cstr)
+}
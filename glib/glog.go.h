@@ -0,0 +1,8 @@
+// Same copyright and license as the rest of the files in this project
+
+#include <glib.h>
+
+static inline void _g_log(const gchar *log_domain, GLogLevelFlags log_level,
+                          const gchar *message) {
+  g_log(log_domain, log_level, "%s", message);
+}
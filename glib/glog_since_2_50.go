@@ -0,0 +1,215 @@
+// Same copyright and license as the rest of the files in this project
+
+// +build !glib_2_40,!glib_2_42,!glib_2_44,!glib_2_46,!glib_2_48
+
+package glib
+
+// #include <stdlib.h>
+// #include <glib.h>
+// #include "glog_since_2_50.go.h"
+import "C"
+import (
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"unsafe"
+)
+
+/*
+ * Structured logging
+ */
+
+// LogWriterOutput is a representation of GLib's GLogWriterOutput.
+type LogWriterOutput int
+
+const (
+	LOG_WRITER_HANDLED   LogWriterOutput = C.G_LOG_WRITER_HANDLED
+	LOG_WRITER_UNHANDLED LogWriterOutput = C.G_LOG_WRITER_UNHANDLED
+)
+
+// LogField is a representation of GLib's GLogField, a key-value pair of a
+// structured log message. The standard keys are listed in the documentation
+// of g_log_structured(), like "MESSAGE", "GLIB_DOMAIN" or "CODE_FILE".
+type LogField struct {
+	Key   string
+	Value string
+}
+
+// LogFields are the fields of a structured log message.
+type LogFields []LogField
+
+// Get returns the value of the first field named key.
+func (f LogFields) Get(key string) (string, bool) {
+	for _, field := range f {
+		if field.Key == key {
+			return field.Value, true
+		}
+	}
+	return "", false
+}
+
+// Domain returns the value of the GLIB_DOMAIN field, e.g. "Gtk".
+func (f LogFields) Domain() string {
+	v, _ := f.Get("GLIB_DOMAIN")
+	return v
+}
+
+// Message returns the value of the MESSAGE field.
+func (f LogFields) Message() string {
+	v, _ := f.Get("MESSAGE")
+	return v
+}
+
+// LogWriterFunc is the type of the functions receiving the log messages of
+// GLib, see SetLogWriter.
+type LogWriterFunc func(level LogLevelFlags, fields LogFields) LogWriterOutput
+
+var (
+	logWriterOnce sync.Once
+	logWriterMu   sync.RWMutex
+	logWriter     LogWriterFunc
+)
+
+// SetLogWriter makes f receive the log messages of GLib and of the
+// libraries using it, like GTK, including those of g_warning() and of the
+// failed g_return_if_fail() assertions. It installs a writer with
+// g_log_set_writer_func() on the first call. Messages for which f returns
+// LOG_WRITER_UNHANDLED, and all messages if f is nil, are passed to
+// g_log_writer_default(), which prints them to stderr.
+//
+// Unlike g_log_set_writer_func(), it may be called several times to replace
+// f. f may be called from any thread and must be safe for concurrent use.
+func SetLogWriter(f LogWriterFunc) {
+	logWriterMu.Lock()
+	logWriter = f
+	logWriterMu.Unlock()
+
+	logWriterOnce.Do(func() {
+		C._g_log_set_writer_func()
+	})
+}
+
+//export goLogWriter
+func goLogWriter(level C.GLogLevelFlags, cfields *C.GLogField, n C.gsize) C.GLogWriterOutput {
+	logWriterMu.RLock()
+	f := logWriter
+	logWriterMu.RUnlock()
+
+	if f == nil {
+		return C.G_LOG_WRITER_UNHANDLED
+	}
+
+	fields := make(LogFields, int(n))
+	for i := range fields {
+		field := C._g_log_field_at(cfields, C.gsize(i))
+		fields[i].Key = C.GoString((*C.char)(field.key))
+		if field.length < 0 {
+			fields[i].Value = C.GoString((*C.char)(field.value))
+		} else {
+			fields[i].Value = C.GoStringN((*C.char)(field.value), C.int(field.length))
+		}
+	}
+	return C.GLogWriterOutput(f(LogLevelFlags(level), fields))
+}
+
+// LogStructuredArray is a wrapper around g_log_structured_array(). fields
+// must contain a MESSAGE field.
+func LogStructuredArray(level LogLevelFlags, fields LogFields) {
+	n := len(fields)
+	cfields := (*C.GLogField)(C.malloc(C.size_t(n) * C.size_t(C.sizeof_GLogField)))
+	defer C.free(unsafe.Pointer(cfields))
+
+	for i, f := range fields {
+		field := C._g_log_field_at(cfields, C.gsize(i))
+		ckey := C.CString(f.Key)
+		defer C.free(unsafe.Pointer(ckey))
+		cvalue := C.CString(f.Value)
+		defer C.free(unsafe.Pointer(cvalue))
+
+		field.key = (*C.gchar)(ckey)
+		field.value = C.gconstpointer(unsafe.Pointer(cvalue))
+		field.length = -1
+	}
+
+	C.g_log_structured_array(C.GLogLevelFlags(level), cfields, C.gsize(n))
+}
+
+// LogMessage is a log message recorded by a LogCollector.
+type LogMessage struct {
+	Level  LogLevelFlags
+	Fields LogFields
+
+	// Stack is the stack trace of the goroutine which logged the message.
+	// It is always captured, but for messages logged on the threads
+	// created by C code, like those of GIO, it only shows the log writer
+	// called from C, not what led to the message.
+	Stack string
+}
+
+// String formats the message as GLib prints it, followed by its stack
+// trace.
+func (m LogMessage) String() string {
+	s := fmt.Sprintf("%s-%s **: %s", m.Fields.Domain(), m.Level, m.Fields.Message())
+	if m.Stack != "" {
+		s += "\n\n" + m.Stack
+	}
+	return s
+}
+
+// LogCollector records the log messages of some levels, to check them once
+// the GLib functions which logged them returned. It is meant to make tests
+// fail on GTK criticals and warnings, see glibtest.FailOnLog:
+//
+//	c := glib.LogCollectorNew(glib.LOG_LEVEL_CRITICAL|glib.LOG_LEVEL_WARNING, nil)
+//	glib.SetLogWriter(c.Writer())
+//	defer glib.SetLogWriter(nil)
+//
+//	button.SetLabel("")
+//	for _, m := range c.Take() {
+//		t.Error(m)
+//	}
+//
+// The writer never panics, since the panic would unwind through GLib, or
+// crash the program on the threads created by C code. Use
+// LogSetAlwaysFatal or LogSetFatalMask to abort on these messages instead.
+type LogCollector struct {
+	mask LogLevelFlags
+	next LogWriterFunc
+
+	mu       sync.Mutex
+	messages []LogMessage
+}
+
+// LogCollectorNew returns a LogCollector recording the messages of the
+// levels in mask. The other messages are passed to next, or to
+// g_log_writer_default() if next is nil.
+func LogCollectorNew(mask LogLevelFlags, next LogWriterFunc) *LogCollector {
+	return &LogCollector{mask: mask, next: next}
+}
+
+// Writer returns the LogWriterFunc of the collector, to be given to
+// SetLogWriter.
+func (c *LogCollector) Writer() LogWriterFunc {
+	return func(level LogLevelFlags, fields LogFields) LogWriterOutput {
+		if level&c.mask != 0 {
+			c.mu.Lock()
+			c.messages = append(c.messages, LogMessage{level, fields, string(debug.Stack())})
+			c.mu.Unlock()
+			return LOG_WRITER_HANDLED
+		}
+		if c.next == nil {
+			return LOG_WRITER_UNHANDLED
+		}
+		return c.next(level, fields)
+	}
+}
+
+// Take returns the messages recorded since the previous call.
+func (c *LogCollector) Take() []LogMessage {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	messages := c.messages
+	c.messages = nil
+	return messages
+}
@@ -0,0 +1,23 @@
+// Same copyright and license as the rest of the files in this project
+
+#include <glib.h>
+
+extern GLogWriterOutput goLogWriter(GLogLevelFlags log_level,
+                                    GLogField *fields, gsize n_fields);
+
+static GLogWriterOutput _gotk_log_writer(GLogLevelFlags log_level,
+                                         const GLogField *fields,
+                                         gsize n_fields, gpointer user_data) {
+  if (goLogWriter(log_level, (GLogField *)fields, n_fields) ==
+      G_LOG_WRITER_HANDLED)
+    return G_LOG_WRITER_HANDLED;
+  return g_log_writer_default(log_level, fields, n_fields, user_data);
+}
+
+static inline void _g_log_set_writer_func() {
+  g_log_set_writer_func(_gotk_log_writer, NULL, NULL);
+}
+
+static inline GLogField *_g_log_field_at(GLogField *fields, gsize i) {
+  return &fields[i];
+}
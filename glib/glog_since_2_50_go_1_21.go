@@ -0,0 +1,58 @@
+// Same copyright and license as the rest of the files in this project
+
+//go:build go1.21 && !glib_2_40 && !glib_2_42 && !glib_2_44 && !glib_2_46 && !glib_2_48
+// +build go1.21,!glib_2_40,!glib_2_42,!glib_2_44,!glib_2_46,!glib_2_48
+
+package glib
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// SlogWriter returns a LogWriterFunc passing the log messages of GLib to
+// handler, e.g.
+//
+//	glib.SetLogWriter(glib.SlogWriter(slog.Default().Handler()))
+//
+// The GLIB_DOMAIN field is recorded as the "domain" attribute, and the other
+// fields but MESSAGE and PRIORITY as attributes named after their keys, like
+// CODE_FILE. Messages of levels not enabled by handler are dropped.
+func SlogWriter(handler slog.Handler) LogWriterFunc {
+	return func(level LogLevelFlags, fields LogFields) LogWriterOutput {
+		ctx := context.Background()
+		l := SlogLevel(level)
+		if !handler.Enabled(ctx, l) {
+			return LOG_WRITER_HANDLED
+		}
+
+		r := slog.NewRecord(time.Now(), l, fields.Message(), 0)
+		for _, f := range fields {
+			switch f.Key {
+			case "MESSAGE", "PRIORITY":
+			case "GLIB_DOMAIN":
+				r.AddAttrs(slog.String("domain", f.Value))
+			default:
+				r.AddAttrs(slog.String(f.Key, f.Value))
+			}
+		}
+		handler.Handle(ctx, r)
+		return LOG_WRITER_HANDLED
+	}
+}
+
+// SlogLevel returns the slog level of the most severe level of l: errors
+// and criticals are slog.LevelError, warnings slog.LevelWarn, messages and
+// infos slog.LevelInfo, and debug messages slog.LevelDebug.
+func SlogLevel(l LogLevelFlags) slog.Level {
+	switch {
+	case l&(LOG_LEVEL_ERROR|LOG_LEVEL_CRITICAL) != 0:
+		return slog.LevelError
+	case l&LOG_LEVEL_WARNING != 0:
+		return slog.LevelWarn
+	case l&(LOG_LEVEL_MESSAGE|LOG_LEVEL_INFO) != 0:
+		return slog.LevelInfo
+	}
+	return slog.LevelDebug
+}
@@ -0,0 +1,33 @@
+//go:build go1.21 && !glib_2_40 && !glib_2_42 && !glib_2_44 && !glib_2_46 && !glib_2_48
+// +build go1.21,!glib_2_40,!glib_2_42,!glib_2_44,!glib_2_46,!glib_2_48
+
+package glib_test
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func TestSlogWriter(t *testing.T) {
+	var buf bytes.Buffer
+	handler := slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo})
+	glib.SetLogWriter(glib.SlogWriter(handler))
+	defer glib.SetLogWriter(nil)
+
+	glib.Log("gotk3-test", glib.LOG_LEVEL_WARNING, "something is off")
+	glib.Log("gotk3-test", glib.LOG_LEVEL_DEBUG, "dropped")
+
+	out := buf.String()
+	for _, s := range []string{"level=WARN", `msg="something is off"`, "domain=gotk3-test"} {
+		if !strings.Contains(out, s) {
+			t.Errorf("expected %s in %q", s, out)
+		}
+	}
+	if strings.Contains(out, "dropped") {
+		t.Errorf("debug message not dropped: %q", out)
+	}
+}
@@ -0,0 +1,126 @@
+// +build !glib_2_40,!glib_2_42,!glib_2_44,!glib_2_46,!glib_2_48
+
+package glib_test
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/glib/glibtest"
+)
+
+type logRecord struct {
+	level  glib.LogLevelFlags
+	fields glib.LogFields
+}
+
+func TestLogWriter(t *testing.T) {
+	var mu sync.Mutex
+	var records []logRecord
+	glib.SetLogWriter(func(level glib.LogLevelFlags, fields glib.LogFields) glib.LogWriterOutput {
+		if fields.Domain() != "gotk3-test" {
+			return glib.LOG_WRITER_UNHANDLED
+		}
+		mu.Lock()
+		records = append(records, logRecord{level, fields})
+		mu.Unlock()
+		return glib.LOG_WRITER_HANDLED
+	})
+	defer glib.SetLogWriter(nil)
+
+	glib.Log("gotk3-test", glib.LOG_LEVEL_WARNING, "something is off")
+	glib.LogStructuredArray(glib.LOG_LEVEL_MESSAGE, glib.LogFields{
+		{"GLIB_DOMAIN", "gotk3-test"},
+		{"MESSAGE", "structured"},
+		{"REQUEST_ID", "42"},
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(records) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(records))
+	}
+
+	r := records[0]
+	if r.level&glib.LOG_LEVEL_WARNING == 0 {
+		t.Errorf("expected a warning, got %s", r.level)
+	}
+	if r.fields.Message() != "something is off" {
+		t.Errorf("unexpected message %q", r.fields.Message())
+	}
+
+	r = records[1]
+	if r.level&glib.LOG_LEVEL_MESSAGE == 0 {
+		t.Errorf("expected a message, got %s", r.level)
+	}
+	if v, ok := r.fields.Get("REQUEST_ID"); !ok || v != "42" {
+		t.Errorf("expected REQUEST_ID field 42, got %q", v)
+	}
+}
+
+func TestLogCollector(t *testing.T) {
+	c := glib.LogCollectorNew(glib.LOG_LEVEL_CRITICAL, nil)
+	glib.SetLogWriter(c.Writer())
+	defer glib.SetLogWriter(nil)
+
+	glib.Log("gotk3-test", glib.LOG_LEVEL_CRITICAL, "assertion failed")
+	glib.Log("gotk3-test", glib.LOG_LEVEL_CRITICAL, "assertion failed again")
+
+	messages := c.Take()
+	if len(messages) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(messages))
+	}
+	msg := messages[0].String()
+	if !strings.Contains(msg, "gotk3-test-CRITICAL **: assertion failed") {
+		t.Errorf("unexpected message %q", msg)
+	}
+	if !strings.Contains(msg, "TestLogCollector") {
+		t.Errorf("expected a stack trace in the message, got %q", msg)
+	}
+	if len(c.Take()) != 0 {
+		t.Error("messages not cleared by Take")
+	}
+}
+
+func TestFailOnLog(t *testing.T) {
+	var ft fakeTB
+	check := glibtest.FailOnLog(&ft, glib.LOG_LEVEL_CRITICAL)
+
+	glib.Log("gotk3-test", glib.LOG_LEVEL_CRITICAL, "assertion failed")
+	check()
+	if len(ft.errors) != 1 || !strings.Contains(ft.errors[0], "assertion failed") {
+		t.Errorf("expected the critical to be reported, got %q", ft.errors)
+	}
+
+	ft.cleanup()
+	glib.Log("gotk3-test", glib.LOG_LEVEL_WARNING, "after the test")
+	if len(ft.errors) != 1 {
+		t.Errorf("expected no report after the test ended, got %q", ft.errors)
+	}
+}
+
+// fakeTB records the errors and cleanup functions of a test.
+type fakeTB struct {
+	testing.TB
+	errors   []string
+	cleanups []func()
+}
+
+func (ft *fakeTB) Helper() {}
+
+func (ft *fakeTB) Errorf(format string, args ...interface{}) {
+	ft.errors = append(ft.errors, fmt.Sprintf(format, args...))
+}
+
+func (ft *fakeTB) Cleanup(f func()) {
+	ft.cleanups = append(ft.cleanups, f)
+}
+
+func (ft *fakeTB) cleanup() {
+	for i := len(ft.cleanups) - 1; i >= 0; i-- {
+		ft.cleanups[i]()
+	}
+}
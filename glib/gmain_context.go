@@ -5,6 +5,10 @@ package glib
 // #include <glib-object.h>
 // #include "glib.go.h"
 import "C"
+import (
+	"github.com/gotk3/gotk3/internal/callback"
+	"github.com/gotk3/gotk3/internal/closure"
+)
 
 type MainContext C.GMainContext
 
@@ -25,6 +29,80 @@ func MainContextDefault() *MainContext {
 	return (*MainContext)(c)
 }
 
+// MainContextNew is a wrapper around g_main_context_new(). The reference
+// returned must be released with Unref once the context is no longer used.
+//
+// A context is typically run by a MainLoop in a goroutine locked to its OS
+// thread with runtime.LockOSThread, after making it the thread-default
+// context with PushThreadDefault, so that the GIO operations started in
+// that goroutine complete in it rather than in the GTK main loop.
+func MainContextNew() *MainContext {
+	c := C.g_main_context_new()
+	if c == nil {
+		return nil
+	}
+	return (*MainContext)(c)
+}
+
+// MainContextGetThreadDefault is a wrapper around
+// g_main_context_get_thread_default(). It returns nil if the thread-default
+// context is the global default context.
+func MainContextGetThreadDefault() *MainContext {
+	c := C.g_main_context_get_thread_default()
+	if c == nil {
+		return nil
+	}
+	return (*MainContext)(c)
+}
+
+// Ref is a wrapper around g_main_context_ref().
+func (v *MainContext) Ref() *MainContext {
+	c := C.g_main_context_ref(v.native())
+	if c == nil {
+		return nil
+	}
+	return (*MainContext)(c)
+}
+
+// Unref is a wrapper around g_main_context_unref().
+func (v *MainContext) Unref() {
+	C.g_main_context_unref(v.native())
+}
+
+// PushThreadDefault is a wrapper around
+// g_main_context_push_thread_default(). The thread-default context is a
+// property of the OS thread, so the calling goroutine must be locked to its
+// thread with runtime.LockOSThread until PopThreadDefault is called.
+func (v *MainContext) PushThreadDefault() {
+	C.g_main_context_push_thread_default(v.native())
+}
+
+// PopThreadDefault is a wrapper around g_main_context_pop_thread_default().
+func (v *MainContext) PopThreadDefault() {
+	C.g_main_context_pop_thread_default(v.native())
+}
+
+// Invoke is a wrapper around g_main_context_invoke_full() calling f with
+// the default priority. f is called right away if the context is owned by
+// the current thread, otherwise from the thread running the context. As
+// with IdleAdd, f is a function with no parameter, and it is called again
+// while it returns true.
+func (v *MainContext) Invoke(f interface{}) {
+	v.invoke(PRIORITY_DEFAULT, f)
+}
+
+// InvokeFull is a wrapper around g_main_context_invoke_full(). It is like
+// Invoke, with the given priority.
+func (v *MainContext) InvokeFull(priority Priority, f interface{}) {
+	v.invoke(priority, f)
+}
+
+func (v *MainContext) invoke(priority Priority, f interface{}) {
+	fs := closure.NewIdleFuncStack(f, 2)
+	id := C.gpointer(callback.Assign(fs))
+	C.g_main_context_invoke_full(v.native(), C.gint(priority), _sourceFunc, id, _removeSourceFunc)
+}
+
 // Iteration is a wrapper around g_main_context_iteration()
 func (v *MainContext) Iteration(mayBlock bool) bool {
 	return gobool(C.g_main_context_iteration(v.native(), gbool(mayBlock)))
@@ -63,3 +141,9 @@ func (v *MainContext) Release() {
 func (v *MainContext) IsOwner() bool {
 	return gobool(C.g_main_context_is_owner(v.native()))
 }
+
+// Wakeup is a wrapper around g_main_context_wakeup(). It makes the thread
+// running the context poll again, so that the sources are checked again.
+func (v *MainContext) Wakeup() {
+	C.g_main_context_wakeup(v.native())
+}
@@ -0,0 +1,89 @@
+package glib_test
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func TestMainContextWorker(t *testing.T) {
+	ctx := glib.MainContextNew()
+	defer ctx.Unref()
+	mainLoop := glib.MainLoopNew(ctx, false)
+
+	started := make(chan struct{})
+	done := make(chan struct{})
+	var threadDefault *glib.MainContext
+	go func() {
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+
+		ctx.PushThreadDefault()
+		defer ctx.PopThreadDefault()
+		threadDefault = glib.MainContextGetThreadDefault()
+
+		close(started)
+		mainLoop.Run()
+		close(done)
+	}()
+	<-started
+
+	if threadDefault != ctx {
+		t.Error("Expected the worker context to be the thread-default context")
+	}
+
+	// Sources attached to the worker context are dispatched by the worker.
+	ticks := make(chan int, 3)
+	var n int
+	timeout := glib.TimeoutSourceNew(5, func() bool {
+		n++
+		ticks <- n
+		return n < 3
+	})
+	timeout.SetPriority(glib.PRIORITY_HIGH)
+	timeout.Attach(ctx)
+	timeout.Unref()
+	for i := 1; i <= 3; i++ {
+		select {
+		case tick := <-ticks:
+			if tick != i {
+				t.Errorf("Expected tick %d, got %d", i, tick)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("Timed out waiting for the timeout source")
+		}
+	}
+
+	readyTimes := make(chan bool, 1)
+	source := glib.SourceNew(&glib.SourceFuncs{
+		Dispatch: func(s *glib.Source) bool {
+			readyTimes <- s.GetTime() >= s.GetReadyTime()
+			return false
+		},
+	})
+	source.SetReadyTime(glib.GetMonotonicTime() + 10000)
+	source.Attach(ctx)
+	source.Unref()
+	select {
+	case ok := <-readyTimes:
+		if !ok {
+			t.Error("Expected the source to be dispatched after its ready time")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the ready time")
+	}
+
+	ctx.Invoke(func() {
+		if !ctx.IsOwner() {
+			t.Error("Expected Invoke to run in the worker")
+		}
+		mainLoop.Quit()
+	})
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the worker to quit")
+	}
+}
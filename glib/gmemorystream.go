@@ -0,0 +1,155 @@
+package glib
+
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "giostream.go.h"
+import "C"
+import (
+	"errors"
+	"unsafe"
+)
+
+func init() {
+
+	tm := []TypeMarshaler{
+		{Type(C.g_memory_input_stream_get_type()), marshalMemoryInputStream},
+		{Type(C.g_memory_output_stream_get_type()), marshalMemoryOutputStream},
+	}
+
+	RegisterGValueMarshalers(tm)
+}
+
+/*
+ * GMemoryInputStream
+ */
+
+// MemoryInputStream is a representation of GIO's GMemoryInputStream.
+// Streaming input operations on memory chunks
+type MemoryInputStream struct {
+	*InputStream
+}
+
+// native returns a pointer to the underlying GMemoryInputStream.
+func (v *MemoryInputStream) native() *C.GMemoryInputStream {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGMemoryInputStream(p)
+}
+
+// Native returns a pointer to the underlying GMemoryInputStream.
+func (v *MemoryInputStream) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalMemoryInputStream(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapMemoryInputStream(obj), nil
+}
+
+func wrapMemoryInputStream(obj *Object) *MemoryInputStream {
+	return &MemoryInputStream{wrapInputStream(obj)}
+}
+
+// MemoryInputStreamNew is a wrapper around g_memory_input_stream_new().
+func MemoryInputStreamNew() *MemoryInputStream {
+	c := C.g_memory_input_stream_new()
+	return wrapMemoryInputStream(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// MemoryInputStreamNewFromBytes is a wrapper around
+// g_memory_input_stream_new_from_bytes(). The stream reads from a copy of
+// data.
+func MemoryInputStreamNewFromBytes(data []byte) *MemoryInputStream {
+	b := newGBytes(data)
+	defer C.g_bytes_unref(b)
+
+	c := C.g_memory_input_stream_new_from_bytes(b)
+	return wrapMemoryInputStream(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// AddBytes is a wrapper around g_memory_input_stream_add_bytes(). A copy of
+// data is appended to the data to be read.
+func (v *MemoryInputStream) AddBytes(data []byte) {
+	b := newGBytes(data)
+	defer C.g_bytes_unref(b)
+
+	C.g_memory_input_stream_add_bytes(v.native(), b)
+}
+
+/*
+ * GMemoryOutputStream
+ */
+
+// MemoryOutputStream is a representation of GIO's GMemoryOutputStream.
+// Streaming output operations on memory chunks
+type MemoryOutputStream struct {
+	*OutputStream
+}
+
+// native returns a pointer to the underlying GMemoryOutputStream.
+func (v *MemoryOutputStream) native() *C.GMemoryOutputStream {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGMemoryOutputStream(p)
+}
+
+// Native returns a pointer to the underlying GMemoryOutputStream.
+func (v *MemoryOutputStream) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalMemoryOutputStream(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapMemoryOutputStream(obj), nil
+}
+
+func wrapMemoryOutputStream(obj *Object) *MemoryOutputStream {
+	return &MemoryOutputStream{wrapOutputStream(obj)}
+}
+
+// MemoryOutputStreamNewResizable is a wrapper around
+// g_memory_output_stream_new_resizable().
+func MemoryOutputStreamNewResizable() *MemoryOutputStream {
+	c := C.g_memory_output_stream_new_resizable()
+	return wrapMemoryOutputStream(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// GetData is a wrapper around g_memory_output_stream_get_data(). It returns
+// a copy of the data written to the stream so far.
+func (v *MemoryOutputStream) GetData() []byte {
+	p := C.g_memory_output_stream_get_data(v.native())
+	size := C.g_memory_output_stream_get_data_size(v.native())
+	if p == nil || size == 0 {
+		return []byte{}
+	}
+	return goByteSlice(unsafe.Pointer(p), size)
+}
+
+// GetSize is a wrapper around g_memory_output_stream_get_size().
+func (v *MemoryOutputStream) GetSize() uint {
+	return uint(C.g_memory_output_stream_get_size(v.native()))
+}
+
+// GetDataSize is a wrapper around g_memory_output_stream_get_data_size().
+func (v *MemoryOutputStream) GetDataSize() uint {
+	return uint(C.g_memory_output_stream_get_data_size(v.native()))
+}
+
+// StealAsBytes is a wrapper around g_memory_output_stream_steal_as_bytes().
+// The stream must be closed first, and an error is returned otherwise. Its
+// data is released.
+func (v *MemoryOutputStream) StealAsBytes() ([]byte, error) {
+	if !v.IsClosed() {
+		return nil, errors.New("memory output stream is not closed")
+	}
+	c := C.g_memory_output_stream_steal_as_bytes(v.native())
+	defer C.g_bytes_unref(c)
+	return goBytes(c), nil
+}
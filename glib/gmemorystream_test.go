@@ -0,0 +1,30 @@
+package glib_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func TestMemoryOutputStreamStealAsBytes(t *testing.T) {
+	mem := glib.MemoryOutputStreamNewResizable()
+	if _, err := mem.Write(bytes.NewBufferString("data"), nil); err != nil {
+		t.Fatal("Write failed:", err)
+	}
+
+	if _, err := mem.StealAsBytes(); err == nil {
+		t.Error("Expected StealAsBytes to fail on an open stream")
+	}
+
+	if _, err := mem.Close(nil); err != nil {
+		t.Fatal("Close failed:", err)
+	}
+	data, err := mem.StealAsBytes()
+	if err != nil {
+		t.Fatal("StealAsBytes failed:", err)
+	}
+	if string(data) != "data" {
+		t.Errorf("Expected %q, got %q", "data", data)
+	}
+}
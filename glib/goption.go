@@ -0,0 +1,60 @@
+package glib
+
+// #include <glib.h>
+import "C"
+
+/*
+ * GOptionArg
+ */
+
+// OptionArg is a representation of GLib's GOptionArg, the kind of argument
+// an option takes.
+type OptionArg int
+
+const (
+	OPTION_ARG_NONE           OptionArg = C.G_OPTION_ARG_NONE
+	OPTION_ARG_STRING         OptionArg = C.G_OPTION_ARG_STRING
+	OPTION_ARG_INT            OptionArg = C.G_OPTION_ARG_INT
+	OPTION_ARG_FILENAME       OptionArg = C.G_OPTION_ARG_FILENAME
+	OPTION_ARG_STRING_ARRAY   OptionArg = C.G_OPTION_ARG_STRING_ARRAY
+	OPTION_ARG_FILENAME_ARRAY OptionArg = C.G_OPTION_ARG_FILENAME_ARRAY
+	OPTION_ARG_DOUBLE         OptionArg = C.G_OPTION_ARG_DOUBLE
+	OPTION_ARG_INT64          OptionArg = C.G_OPTION_ARG_INT64
+)
+
+/*
+ * GOptionFlags
+ */
+
+// OptionFlags is a representation of GLib's GOptionFlags.
+type OptionFlags int
+
+const (
+	OPTION_FLAG_NONE         OptionFlags = C.G_OPTION_FLAG_NONE
+	OPTION_FLAG_HIDDEN       OptionFlags = C.G_OPTION_FLAG_HIDDEN
+	OPTION_FLAG_IN_MAIN      OptionFlags = C.G_OPTION_FLAG_IN_MAIN
+	OPTION_FLAG_REVERSE      OptionFlags = C.G_OPTION_FLAG_REVERSE
+	OPTION_FLAG_NO_ARG       OptionFlags = C.G_OPTION_FLAG_NO_ARG
+	OPTION_FLAG_FILENAME     OptionFlags = C.G_OPTION_FLAG_FILENAME
+	OPTION_FLAG_OPTIONAL_ARG OptionFlags = C.G_OPTION_FLAG_OPTIONAL_ARG
+	OPTION_FLAG_NOALIAS      OptionFlags = C.G_OPTION_FLAG_NOALIAS
+)
+
+/*
+ * GOptionEntry
+ */
+
+// OptionEntry is a representation of GLib's GOptionEntry, describing a
+// command line option. The value of a parsed option is not stored through a
+// pointer as in C, it is found in the options VariantDict of the
+// application instead, under LongName. The type of the value depends on Arg:
+// OPTION_ARG_NONE options are booleans, OPTION_ARG_STRING ones strings,
+// OPTION_ARG_FILENAME ones bytestrings, and so on.
+type OptionEntry struct {
+	LongName       string
+	ShortName      byte
+	Flags          OptionFlags
+	Arg            OptionArg
+	Description    string
+	ArgDescription string
+}
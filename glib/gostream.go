@@ -0,0 +1,222 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+// #include "gostream.go.h"
+import "C"
+import (
+	"io"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/internal/callback"
+)
+
+/*
+ * GInputStream and GOutputStream implemented in Go
+ */
+
+// InputStreamNewForReader creates a GInputStream reading from r, so that Go
+// readers such as HTTP response bodies can be passed to functions taking a
+// GInputStream. Closing the stream closes r if it is an io.Closer. r is kept
+// alive until the stream is finalized.
+//
+// Reads may happen in a GIO worker thread when the stream is used
+// asynchronously. Cancellables cannot interrupt a Read in progress.
+func InputStreamNewForReader(r io.Reader) (*InputStream, error) {
+	id := callback.Assign(&goReader{r: r})
+	c := C._gotk_go_input_stream_new(C.guint(id))
+	if c == nil {
+		callback.Delete(id)
+		return nil, nilPtrErr
+	}
+	return wrapInputStream(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// OutputStreamNewForWriter creates a GOutputStream writing to w. Flushing
+// the stream flushes w if it has a Flush() error method, like bufio.Writer,
+// and closing the stream closes w if it is an io.Closer. w is kept alive
+// until the stream is finalized. An error returned by w along with a short
+// write is reported by the next write, flush or close.
+//
+// Writes may happen in a GIO worker thread when the stream is used
+// asynchronously. Cancellables cannot interrupt a Write in progress.
+func OutputStreamNewForWriter(w io.Writer) (*OutputStream, error) {
+	id := callback.Assign(&goWriter{w: w})
+	c := C._gotk_go_output_stream_new(C.guint(id))
+	if c == nil {
+		callback.Delete(id)
+		return nil, nilPtrErr
+	}
+	return wrapOutputStream(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// maxStreamBuffer is the largest buffer passed to a Go reader or writer.
+// Larger reads and writes are cut short, which GIO allows.
+const maxStreamBuffer = 1 << 30
+
+// streamBuffer returns a Go slice backed by a buffer of a stream vfunc, of
+// at most maxStreamBuffer bytes.
+func streamBuffer(buffer unsafe.Pointer, count C.gsize) []byte {
+	if count == 0 {
+		return nil
+	}
+	if count > maxStreamBuffer {
+		count = maxStreamBuffer
+	}
+	return (*[maxStreamBuffer]byte)(buffer)[:count:count]
+}
+
+//export goStreamFinalize
+func goStreamFinalize(id C.guint) {
+	callback.Delete(uintptr(id))
+}
+
+// maxConsecutiveEmptyReads is the number of reads returning no data and no
+// error after which reading a Go reader fails with io.ErrNoProgress, as in
+// bufio.
+const maxConsecutiveEmptyReads = 100
+
+// goReader is the Go reader of a GInputStream created by
+// InputStreamNewForReader. GIO does not call read_fn concurrently on a
+// stream, so it needs no locking.
+type goReader struct {
+	r   io.Reader
+	err error // returned by the next read, after the data read with it
+}
+
+// read reads from the Go reader into p, adapting io.Reader semantics to
+// GInputStream: GIO takes a read of 0 bytes as the end of the stream, and
+// has no way to return data along with an error.
+func (gr *goReader) read(p []byte) (int, error) {
+	if err := gr.err; err != nil {
+		gr.err = nil
+		return 0, err
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	for i := 0; i < maxConsecutiveEmptyReads; i++ {
+		n, err := gr.r.Read(p)
+		if n > 0 {
+			if err != io.EOF {
+				gr.err = err
+			}
+			return n, nil
+		}
+		if err == io.EOF {
+			return 0, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	return 0, io.ErrNoProgress
+}
+
+// Close closes the Go reader if it is an io.Closer.
+func (gr *goReader) Close() error {
+	if c, ok := gr.r.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+//export goInputStreamRead
+func goInputStreamRead(id C.guint, buffer unsafe.Pointer, count C.gsize, errorMessage **C.char) C.gssize {
+	gr := callback.Get(uintptr(id)).(*goReader)
+
+	n, err := gr.read(streamBuffer(buffer, count))
+	if err != nil {
+		*errorMessage = C.CString(err.Error())
+		return -1
+	}
+	return C.gssize(n)
+}
+
+// goWriter is the Go writer of a GOutputStream created by
+// OutputStreamNewForWriter.
+type goWriter struct {
+	w   io.Writer
+	err error // returned by the next write, flush or close
+}
+
+// takeErr returns the error kept from a short write, and clears it.
+func (gw *goWriter) takeErr() error {
+	err := gw.err
+	gw.err = nil
+	return err
+}
+
+// write writes p to the Go writer. GIO has no way to return a short count
+// along with an error, so such an error is kept for the next call.
+func (gw *goWriter) write(p []byte) (int, error) {
+	if err := gw.takeErr(); err != nil {
+		return 0, err
+	}
+
+	n, err := gw.w.Write(p)
+	if err != nil && n > 0 {
+		gw.err = err
+		return n, nil
+	}
+	return n, err
+}
+
+// Flush flushes the Go writer if it has a Flush() error method.
+func (gw *goWriter) Flush() error {
+	if err := gw.takeErr(); err != nil {
+		return err
+	}
+	if f, ok := gw.w.(interface{ Flush() error }); ok {
+		return f.Flush()
+	}
+	return nil
+}
+
+// Close closes the Go writer if it is an io.Closer.
+func (gw *goWriter) Close() error {
+	err := gw.takeErr()
+	if c, ok := gw.w.(io.Closer); ok {
+		if cerr := c.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+//export goOutputStreamWrite
+func goOutputStreamWrite(id C.guint, buffer unsafe.Pointer, count C.gsize, errorMessage **C.char) C.gssize {
+	gw := callback.Get(uintptr(id)).(*goWriter)
+
+	n, err := gw.write(streamBuffer(buffer, count))
+	if err != nil {
+		*errorMessage = C.CString(err.Error())
+		return -1
+	}
+	return C.gssize(n)
+}
+
+//export goOutputStreamFlush
+func goOutputStreamFlush(id C.guint, errorMessage **C.char) C.gboolean {
+	if err := callback.Get(uintptr(id)).(*goWriter).Flush(); err != nil {
+		*errorMessage = C.CString(err.Error())
+		return gbool(false)
+	}
+	return gbool(true)
+}
+
+//export goStreamClose
+func goStreamClose(id C.guint, errorMessage **C.char) C.gboolean {
+	if c, ok := callback.Get(uintptr(id)).(io.Closer); ok {
+		if err := c.Close(); err != nil {
+			*errorMessage = C.CString(err.Error())
+			return gbool(false)
+		}
+	}
+	return gbool(true)
+}
@@ -0,0 +1,189 @@
+// Same copyright and license as the rest of the files in this project
+
+#ifndef __GOSTREAM_GO_H__
+#define __GOSTREAM_GO_H__
+
+#include <gio/gio.h>
+#include <glib-object.h>
+#include <glib.h>
+#include <stdlib.h>
+
+// _gotk_stream_set_error sets error from a message allocated by the Go side.
+static void _gotk_stream_set_error(GError **error, char *message) {
+  g_set_error_literal(error, G_IO_ERROR, G_IO_ERROR_FAILED, message);
+  free(message);
+}
+
+/*
+ * GotkGoInputStream is a GInputStream reading from a Go io.Reader, found with
+ * the callback id stored in the instance.
+ */
+typedef struct {
+  GInputStream parent;
+  guint id;
+} GotkGoInputStream;
+
+typedef struct {
+  GInputStreamClass parent_class;
+} GotkGoInputStreamClass;
+
+extern void goStreamFinalize(guint id);
+extern gssize goInputStreamRead(guint id, void *buffer, gsize count,
+                                char **error_message);
+extern gboolean goStreamClose(guint id, char **error_message);
+
+static gssize _gotk_go_input_stream_read(GInputStream *stream, void *buffer,
+                                         gsize count,
+                                         GCancellable *cancellable,
+                                         GError **error) {
+  char *message = NULL;
+  gssize n = goInputStreamRead(((GotkGoInputStream *)stream)->id, buffer,
+                               count, &message);
+  if (message != NULL) {
+    _gotk_stream_set_error(error, message);
+    return -1;
+  }
+  return n;
+}
+
+static gboolean _gotk_go_input_stream_close(GInputStream *stream,
+                                            GCancellable *cancellable,
+                                            GError **error) {
+  char *message = NULL;
+  goStreamClose(((GotkGoInputStream *)stream)->id, &message);
+  if (message != NULL) {
+    _gotk_stream_set_error(error, message);
+    return FALSE;
+  }
+  return TRUE;
+}
+
+static GObjectClass *_gotk_go_input_stream_parent_class = NULL;
+
+static void _gotk_go_input_stream_finalize(GObject *object) {
+  goStreamFinalize(((GotkGoInputStream *)object)->id);
+  _gotk_go_input_stream_parent_class->finalize(object);
+}
+
+static void _gotk_go_input_stream_class_init(gpointer g_class,
+                                             gpointer class_data) {
+  _gotk_go_input_stream_parent_class = g_type_class_peek_parent(g_class);
+  G_OBJECT_CLASS(g_class)->finalize = _gotk_go_input_stream_finalize;
+  G_INPUT_STREAM_CLASS(g_class)->read_fn = _gotk_go_input_stream_read;
+  G_INPUT_STREAM_CLASS(g_class)->close_fn = _gotk_go_input_stream_close;
+}
+
+static GType _gotk_go_input_stream_get_type() {
+  static gsize type_id = 0;
+
+  if (g_once_init_enter(&type_id)) {
+    GType t = g_type_register_static_simple(
+        G_TYPE_INPUT_STREAM, "GotkGoInputStream",
+        sizeof(GotkGoInputStreamClass), _gotk_go_input_stream_class_init,
+        sizeof(GotkGoInputStream), NULL, 0);
+    g_once_init_leave(&type_id, t);
+  }
+
+  return type_id;
+}
+
+static GInputStream *_gotk_go_input_stream_new(guint id) {
+  GotkGoInputStream *stream =
+      g_object_new(_gotk_go_input_stream_get_type(), NULL);
+  stream->id = id;
+  return G_INPUT_STREAM(stream);
+}
+
+/*
+ * GotkGoOutputStream is a GOutputStream writing to a Go io.Writer, found with
+ * the callback id stored in the instance.
+ */
+typedef struct {
+  GOutputStream parent;
+  guint id;
+} GotkGoOutputStream;
+
+typedef struct {
+  GOutputStreamClass parent_class;
+} GotkGoOutputStreamClass;
+
+extern gssize goOutputStreamWrite(guint id, void *buffer, gsize count,
+                                  char **error_message);
+extern gboolean goOutputStreamFlush(guint id, char **error_message);
+
+static gssize _gotk_go_output_stream_write(GOutputStream *stream,
+                                           const void *buffer, gsize count,
+                                           GCancellable *cancellable,
+                                           GError **error) {
+  char *message = NULL;
+  gssize n = goOutputStreamWrite(((GotkGoOutputStream *)stream)->id,
+                                 (void *)buffer, count, &message);
+  if (message != NULL) {
+    _gotk_stream_set_error(error, message);
+    return -1;
+  }
+  return n;
+}
+
+static gboolean _gotk_go_output_stream_flush(GOutputStream *stream,
+                                             GCancellable *cancellable,
+                                             GError **error) {
+  char *message = NULL;
+  goOutputStreamFlush(((GotkGoOutputStream *)stream)->id, &message);
+  if (message != NULL) {
+    _gotk_stream_set_error(error, message);
+    return FALSE;
+  }
+  return TRUE;
+}
+
+static gboolean _gotk_go_output_stream_close(GOutputStream *stream,
+                                             GCancellable *cancellable,
+                                             GError **error) {
+  char *message = NULL;
+  goStreamClose(((GotkGoOutputStream *)stream)->id, &message);
+  if (message != NULL) {
+    _gotk_stream_set_error(error, message);
+    return FALSE;
+  }
+  return TRUE;
+}
+
+static GObjectClass *_gotk_go_output_stream_parent_class = NULL;
+
+static void _gotk_go_output_stream_finalize(GObject *object) {
+  goStreamFinalize(((GotkGoOutputStream *)object)->id);
+  _gotk_go_output_stream_parent_class->finalize(object);
+}
+
+static void _gotk_go_output_stream_class_init(gpointer g_class,
+                                              gpointer class_data) {
+  _gotk_go_output_stream_parent_class = g_type_class_peek_parent(g_class);
+  G_OBJECT_CLASS(g_class)->finalize = _gotk_go_output_stream_finalize;
+  G_OUTPUT_STREAM_CLASS(g_class)->write_fn = _gotk_go_output_stream_write;
+  G_OUTPUT_STREAM_CLASS(g_class)->flush = _gotk_go_output_stream_flush;
+  G_OUTPUT_STREAM_CLASS(g_class)->close_fn = _gotk_go_output_stream_close;
+}
+
+static GType _gotk_go_output_stream_get_type() {
+  static gsize type_id = 0;
+
+  if (g_once_init_enter(&type_id)) {
+    GType t = g_type_register_static_simple(
+        G_TYPE_OUTPUT_STREAM, "GotkGoOutputStream",
+        sizeof(GotkGoOutputStreamClass), _gotk_go_output_stream_class_init,
+        sizeof(GotkGoOutputStream), NULL, 0);
+    g_once_init_leave(&type_id, t);
+  }
+
+  return type_id;
+}
+
+static GOutputStream *_gotk_go_output_stream_new(guint id) {
+  GotkGoOutputStream *stream =
+      g_object_new(_gotk_go_output_stream_get_type(), NULL);
+  stream->id = id;
+  return G_OUTPUT_STREAM(stream);
+}
+
+#endif
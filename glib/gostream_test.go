@@ -0,0 +1,167 @@
+package glib_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+type closeRecorder struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestGoStreams(t *testing.T) {
+	const text = "The quick brown fox jumps over the lazy dog"
+
+	in, err := glib.InputStreamNewForReader(strings.NewReader(text))
+	if err != nil {
+		t.Fatal("InputStreamNewForReader failed:", err)
+	}
+	dst := &closeRecorder{}
+	out, err := glib.OutputStreamNewForWriter(dst)
+	if err != nil {
+		t.Fatal("OutputStreamNewForWriter failed:", err)
+	}
+
+	// Both directions: the Go reader is read through the GInputStream, and
+	// written through the GOutputStream to the Go writer.
+	w := out.AsWriter(nil)
+	if _, err := io.Copy(w, in.AsReader(nil)); err != nil {
+		t.Fatal("Copy failed:", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal("Close failed:", err)
+	}
+
+	if dst.String() != text {
+		t.Errorf("Expected %q, got %q", text, dst.String())
+	}
+	if !dst.closed {
+		t.Error("Expected the writer to be closed with the stream")
+	}
+	if !out.IsClosed() {
+		t.Error("Expected the output stream to be closed")
+	}
+
+	// Reading from a closed stream fails.
+	r := in.AsReader(nil)
+	r.Close()
+	if _, err := ioutil.ReadAll(r); err == nil {
+		t.Error("Expected reading a closed stream to fail")
+	}
+}
+
+// stuckReader returns data and err once, then no data and no error.
+type stuckReader struct {
+	data string
+	err  error
+}
+
+func (r *stuckReader) Read(p []byte) (int, error) {
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	err := r.err
+	r.err = nil
+	return n, err
+}
+
+func TestGoInputStreamErrors(t *testing.T) {
+	in, err := glib.InputStreamNewForReader(&stuckReader{data: "partial", err: errors.New("broken pipe")})
+	if err != nil {
+		t.Fatal("InputStreamNewForReader failed:", err)
+	}
+
+	// The error returned with data is reported by the next read.
+	data, err := ioutil.ReadAll(in.AsReader(nil))
+	if string(data) != "partial" {
+		t.Errorf("Expected %q, got %q", "partial", data)
+	}
+	if err == nil || !strings.Contains(err.Error(), "broken pipe") {
+		t.Errorf("Expected the error of the reader, got %v", err)
+	}
+
+	// A reader that makes no progress fails instead of blocking the stream.
+	if _, err := ioutil.ReadAll(in.AsReader(nil)); err == nil || !strings.Contains(err.Error(), io.ErrNoProgress.Error()) {
+		t.Errorf("Expected %v, got %v", io.ErrNoProgress, err)
+	}
+}
+
+type discardWriter struct{}
+
+func (discardWriter) Write(p []byte) (int, error) {
+	return len(p), nil
+}
+
+func TestGoStreamsLargeBuffers(t *testing.T) {
+	if testing.Short() {
+		t.Skip("allocates buffers over 1 GiB")
+	}
+	const size = 1<<30 + 1
+
+	in, err := glib.InputStreamNewForReader(strings.NewReader("data"))
+	if err != nil {
+		t.Fatal("InputStreamNewForReader failed:", err)
+	}
+	if _, n, err := in.Read(size, nil); err != nil || n != 4 {
+		t.Errorf("Read returned %d, %v", n, err)
+	}
+
+	out, err := glib.OutputStreamNewForWriter(discardWriter{})
+	if err != nil {
+		t.Fatal("OutputStreamNewForWriter failed:", err)
+	}
+	// The write is cut short at 1 GiB.
+	if n, err := out.Write(bytes.NewBuffer(make([]byte, size)), nil); err != nil || n != 1<<30 {
+		t.Errorf("Write returned %d, %v", n, err)
+	}
+}
+
+// failOnceWriter writes half of the first buffer and fails, then succeeds.
+type failOnceWriter struct {
+	bytes.Buffer
+	failed bool
+}
+
+func (w *failOnceWriter) Write(p []byte) (int, error) {
+	if !w.failed && len(p) > 1 {
+		w.failed = true
+		n, _ := w.Buffer.Write(p[:len(p)/2])
+		return n, errors.New("disk full")
+	}
+	return w.Buffer.Write(p)
+}
+
+func TestGoOutputStreamErrors(t *testing.T) {
+	dst := &failOnceWriter{}
+	out, err := glib.OutputStreamNewForWriter(dst)
+	if err != nil {
+		t.Fatal("OutputStreamNewForWriter failed:", err)
+	}
+
+	// The short write is reported first, then its error.
+	if n, err := out.Write(bytes.NewBufferString("abcd"), nil); err != nil || n != 2 {
+		t.Errorf("Expected a short write of 2 bytes, got %d, %v", n, err)
+	}
+	if _, err := out.Write(bytes.NewBufferString("cd"), nil); err == nil || !strings.Contains(err.Error(), "disk full") {
+		t.Errorf("Expected the error of the writer, got %v", err)
+	}
+
+	// The writer works again afterwards.
+	if n, err := out.Write(bytes.NewBufferString("cd"), nil); err != nil || n != 2 {
+		t.Errorf("Write returned %d, %v", n, err)
+	}
+	if dst.String() != "abcd" {
+		t.Errorf("Expected %q, got %q", "abcd", dst.String())
+	}
+}
@@ -0,0 +1,207 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+import "C"
+import (
+	"runtime"
+	"unsafe"
+)
+
+// ParamFlags is a representation of GLib's GParamFlags.
+type ParamFlags int
+
+const (
+	PARAM_READABLE       ParamFlags = C.G_PARAM_READABLE
+	PARAM_WRITABLE       ParamFlags = C.G_PARAM_WRITABLE
+	PARAM_READWRITE      ParamFlags = C.G_PARAM_READWRITE
+	PARAM_CONSTRUCT      ParamFlags = C.G_PARAM_CONSTRUCT
+	PARAM_CONSTRUCT_ONLY ParamFlags = C.G_PARAM_CONSTRUCT_ONLY
+	PARAM_LAX_VALIDATION ParamFlags = C.G_PARAM_LAX_VALIDATION
+	PARAM_DEPRECATED     ParamFlags = C.G_PARAM_DEPRECATED
+)
+
+/*
+ * GParamSpec
+ */
+
+// ParamSpec is a representation of GLib's GParamSpec.
+type ParamSpec struct {
+	GParamSpec *C.GParamSpec
+}
+
+// native returns a pointer to the underlying GParamSpec.
+func (v *ParamSpec) native() *C.GParamSpec {
+	if v == nil || v.GParamSpec == nil {
+		return nil
+	}
+	return v.GParamSpec
+}
+
+// Native returns a pointer to the underlying GParamSpec.
+func (v *ParamSpec) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+// newParamSpec wraps a native GParamSpec without taking a reference.
+func newParamSpec(p *C.GParamSpec) *ParamSpec {
+	if p == nil {
+		return nil
+	}
+	return &ParamSpec{p}
+}
+
+// takeParamSpec wraps a native GParamSpec, sinks its floating reference and
+// sets up a finalizer to release it during GC.
+func takeParamSpec(p *C.GParamSpec) (*ParamSpec, error) {
+	if p == nil {
+		return nil, nilPtrErr
+	}
+	v := &ParamSpec{p}
+	C.g_param_spec_ref_sink(p)
+	runtime.SetFinalizer(v, func(v *ParamSpec) { FinalizerStrategy(v.Unref) })
+	return v, nil
+}
+
+// Ref is a wrapper around g_param_spec_ref().
+func (v *ParamSpec) Ref() {
+	C.g_param_spec_ref(v.native())
+}
+
+// Unref is a wrapper around g_param_spec_unref().
+func (v *ParamSpec) Unref() {
+	C.g_param_spec_unref(v.native())
+}
+
+// GetName is a wrapper around g_param_spec_get_name().
+func (v *ParamSpec) GetName() string {
+	return C.GoString((*C.char)(C.g_param_spec_get_name(v.native())))
+}
+
+// GetNick is a wrapper around g_param_spec_get_nick().
+func (v *ParamSpec) GetNick() string {
+	return C.GoString((*C.char)(C.g_param_spec_get_nick(v.native())))
+}
+
+// GetBlurb is a wrapper around g_param_spec_get_blurb().
+func (v *ParamSpec) GetBlurb() string {
+	return C.GoString((*C.char)(C.g_param_spec_get_blurb(v.native())))
+}
+
+// GetFlags returns the flags of the GParamSpec.
+func (v *ParamSpec) GetFlags() ParamFlags {
+	return ParamFlags(v.native().flags)
+}
+
+// GetValueType returns the Type of the values the GParamSpec describes.
+func (v *ParamSpec) GetValueType() Type {
+	return Type(v.native().value_type)
+}
+
+// GetOwnerType returns the Type of the class which installed the GParamSpec.
+func (v *ParamSpec) GetOwnerType() Type {
+	return Type(v.native().owner_type)
+}
+
+// GetDefaultValue is a wrapper around g_param_value_set_default(). It returns
+// a new Value holding the default value of the GParamSpec.
+func (v *ParamSpec) GetDefaultValue() (*Value, error) {
+	val, err := ValueInit(v.GetValueType())
+	if err != nil {
+		return nil, err
+	}
+	C.g_param_value_set_default(v.native(), val.native())
+	return val, nil
+}
+
+// paramSpecStrings allocates the C strings shared by all g_param_spec_*()
+// constructors. The returned function frees them.
+func paramSpecStrings(name, nick, blurb string) (cname, cnick, cblurb *C.gchar, free func()) {
+	cname = (*C.gchar)(C.CString(name))
+	cnick = (*C.gchar)(C.CString(nick))
+	cblurb = (*C.gchar)(C.CString(blurb))
+	return cname, cnick, cblurb, func() {
+		C.free(unsafe.Pointer(cname))
+		C.free(unsafe.Pointer(cnick))
+		C.free(unsafe.Pointer(cblurb))
+	}
+}
+
+// ParamSpecBoolean is a wrapper around g_param_spec_boolean().
+func ParamSpecBoolean(name, nick, blurb string, defaultValue bool, flags ParamFlags) (*ParamSpec, error) {
+	cname, cnick, cblurb, free := paramSpecStrings(name, nick, blurb)
+	defer free()
+
+	c := C.g_param_spec_boolean(cname, cnick, cblurb, gbool(defaultValue), C.GParamFlags(flags))
+	return takeParamSpec(c)
+}
+
+// ParamSpecInt is a wrapper around g_param_spec_int().
+func ParamSpecInt(name, nick, blurb string, min, max, defaultValue int, flags ParamFlags) (*ParamSpec, error) {
+	cname, cnick, cblurb, free := paramSpecStrings(name, nick, blurb)
+	defer free()
+
+	c := C.g_param_spec_int(cname, cnick, cblurb, C.gint(min), C.gint(max), C.gint(defaultValue), C.GParamFlags(flags))
+	return takeParamSpec(c)
+}
+
+// ParamSpecUint is a wrapper around g_param_spec_uint().
+func ParamSpecUint(name, nick, blurb string, min, max, defaultValue uint, flags ParamFlags) (*ParamSpec, error) {
+	cname, cnick, cblurb, free := paramSpecStrings(name, nick, blurb)
+	defer free()
+
+	c := C.g_param_spec_uint(cname, cnick, cblurb, C.guint(min), C.guint(max), C.guint(defaultValue), C.GParamFlags(flags))
+	return takeParamSpec(c)
+}
+
+// ParamSpecInt64 is a wrapper around g_param_spec_int64().
+func ParamSpecInt64(name, nick, blurb string, min, max, defaultValue int64, flags ParamFlags) (*ParamSpec, error) {
+	cname, cnick, cblurb, free := paramSpecStrings(name, nick, blurb)
+	defer free()
+
+	c := C.g_param_spec_int64(cname, cnick, cblurb, C.gint64(min), C.gint64(max), C.gint64(defaultValue), C.GParamFlags(flags))
+	return takeParamSpec(c)
+}
+
+// ParamSpecDouble is a wrapper around g_param_spec_double().
+func ParamSpecDouble(name, nick, blurb string, min, max, defaultValue float64, flags ParamFlags) (*ParamSpec, error) {
+	cname, cnick, cblurb, free := paramSpecStrings(name, nick, blurb)
+	defer free()
+
+	c := C.g_param_spec_double(cname, cnick, cblurb, C.gdouble(min), C.gdouble(max), C.gdouble(defaultValue), C.GParamFlags(flags))
+	return takeParamSpec(c)
+}
+
+// ParamSpecString is a wrapper around g_param_spec_string().
+func ParamSpecString(name, nick, blurb string, defaultValue string, flags ParamFlags) (*ParamSpec, error) {
+	cname, cnick, cblurb, free := paramSpecStrings(name, nick, blurb)
+	defer free()
+
+	cdefault := (*C.gchar)(C.CString(defaultValue))
+	defer C.free(unsafe.Pointer(cdefault))
+
+	c := C.g_param_spec_string(cname, cnick, cblurb, cdefault, C.GParamFlags(flags))
+	return takeParamSpec(c)
+}
+
+// ParamSpecEnum is a wrapper around g_param_spec_enum(). enumType must be a
+// registered enumeration type, e.g. the one of gtk.Orientation.
+func ParamSpecEnum(name, nick, blurb string, enumType Type, defaultValue int, flags ParamFlags) (*ParamSpec, error) {
+	cname, cnick, cblurb, free := paramSpecStrings(name, nick, blurb)
+	defer free()
+
+	c := C.g_param_spec_enum(cname, cnick, cblurb, C.GType(enumType), C.gint(defaultValue), C.GParamFlags(flags))
+	return takeParamSpec(c)
+}
+
+// ParamSpecObject is a wrapper around g_param_spec_object().
+func ParamSpecObject(name, nick, blurb string, objectType Type, flags ParamFlags) (*ParamSpec, error) {
+	cname, cnick, cblurb, free := paramSpecStrings(name, nick, blurb)
+	defer free()
+
+	c := C.g_param_spec_object(cname, cnick, cblurb, C.GType(objectType), C.GParamFlags(flags))
+	return takeParamSpec(c)
+}
@@ -0,0 +1,220 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+// #include "gsignal.go.h"
+import "C"
+import (
+	"errors"
+	"fmt"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/internal/callback"
+)
+
+// SignalFlags is a representation of GLib's GSignalFlags.
+type SignalFlags int
+
+const (
+	SIGNAL_RUN_FIRST    SignalFlags = C.G_SIGNAL_RUN_FIRST
+	SIGNAL_RUN_LAST     SignalFlags = C.G_SIGNAL_RUN_LAST
+	SIGNAL_RUN_CLEANUP  SignalFlags = C.G_SIGNAL_RUN_CLEANUP
+	SIGNAL_NO_RECURSE   SignalFlags = C.G_SIGNAL_NO_RECURSE
+	SIGNAL_DETAILED     SignalFlags = C.G_SIGNAL_DETAILED
+	SIGNAL_ACTION       SignalFlags = C.G_SIGNAL_ACTION
+	SIGNAL_NO_HOOKS     SignalFlags = C.G_SIGNAL_NO_HOOKS
+	SIGNAL_MUST_COLLECT SignalFlags = C.G_SIGNAL_MUST_COLLECT
+	SIGNAL_DEPRECATED   SignalFlags = C.G_SIGNAL_DEPRECATED
+)
+
+// SignalAccumulator is a representation of GSignalAccumulator. It is called
+// after each handler of a signal with a return value has run, and decides
+// what the emission returns: returnAccu holds the value collected so far,
+// handlerReturn the value returned by the last handler. The emission stops
+// when SignalAccumulator returns false.
+type SignalAccumulator func(returnAccu, handlerReturn *Value) bool
+
+// SignalAccumulatorTrueHandled is the Go equivalent of
+// g_signal_accumulator_true_handled(). It is meant for signals returning a
+// bool and stops the emission as soon as a handler returns true.
+func SignalAccumulatorTrueHandled(returnAccu, handlerReturn *Value) bool {
+	handled := C.g_value_get_boolean(handlerReturn.native())
+	C.g_value_set_boolean(returnAccu.native(), handled)
+	return !gobool(handled)
+}
+
+// SignalAccumulatorFirstWins is the Go equivalent of
+// g_signal_accumulator_first_wins(). The value returned by the first handler
+// is the one returned by the emission, the remaining handlers are not run.
+func SignalAccumulatorFirstWins(returnAccu, handlerReturn *Value) bool {
+	C.g_value_copy(handlerReturn.native(), returnAccu.native())
+	return false
+}
+
+//export goSignalAccumulator
+func goSignalAccumulator(ihint *C.GSignalInvocationHint, returnAccu, handlerReturn *C.GValue, data C.gpointer) C.gboolean {
+	fn := callback.Get(uintptr(data)).(SignalAccumulator)
+	return gbool(fn(&Value{returnAccu}, &Value{handlerReturn}))
+}
+
+// SignalNewFull is a wrapper around g_signal_newv(). Unlike SignalNew and
+// SignalNewV, it creates the signal on the given instance type, which may be
+// a type registered with RegisterType.
+//
+// Parameters:
+//   - signalName   : The name for the signal.
+//   - itype        : The type the signal is created for.
+//   - flags        : When to run the class closure, and other properties of the signal.
+//   - classClosure : Default handler run in the stage given by flags, with the same rules as Connect; may be nil.
+//   - accumulator  : Collects the return values of the handlers; may be nil.
+//   - returnType   : The type of return value, or TYPE_NONE for a signal without a return value.
+//   - paramTypes   : The types of the parameters, not counting the instance.
+func SignalNewFull(
+	signalName string,
+	itype Type,
+	flags SignalFlags,
+	classClosure interface{},
+	accumulator SignalAccumulator,
+	returnType Type,
+	paramTypes ...Type,
+) (*Signal, error) {
+	if !SignalIsValidName(signalName) {
+		return nil, fmt.Errorf("invalid signal name: %s", signalName)
+	}
+
+	cstr := C.CString(signalName)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var gclosure *C.GClosure
+	if classClosure != nil {
+		gclosure = ClosureNew(classClosure)
+	}
+
+	var accuData C.gpointer
+	if accumulator != nil {
+		accuData = C.gpointer(callback.Assign(accumulator))
+	}
+
+	var gtypes *C.GType
+	if len(paramTypes) > 0 {
+		params := make([]C.GType, len(paramTypes))
+		for i, t := range paramTypes {
+			params[i] = C.GType(t)
+		}
+		gtypes = &params[0]
+	}
+
+	signalId := C._g_signal_newv_full(
+		(*C.gchar)(cstr),
+		C.GType(itype),
+		C.GSignalFlags(flags),
+		gclosure,
+		gbool(accumulator != nil),
+		accuData,
+		C.GType(returnType),
+		C.guint(len(paramTypes)),
+		gtypes)
+
+	if signalId == 0 {
+		if accumulator != nil {
+			callback.Delete(uintptr(accuData))
+		}
+		return nil, fmt.Errorf("cannot register signal %s on type %s", signalName, itype.Name())
+	}
+
+	return &Signal{
+		name:     signalName,
+		signalId: signalId,
+	}, nil
+}
+
+// signalSignature is the declared signature of a signal, as returned by
+// g_signal_query().
+type signalSignature struct {
+	returnType Type
+	paramTypes []Type
+}
+
+// lookupSignal is a wrapper around g_signal_parse_name() and g_signal_query().
+func lookupSignal(detailedSignal string, t Type) (C.guint, C.GQuark, *signalSignature, error) {
+	cstr := C.CString(detailedSignal)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var id C.guint
+	var detail C.GQuark
+	if !gobool(C.g_signal_parse_name((*C.gchar)(cstr), C.GType(t), &id, &detail, C.FALSE)) {
+		return 0, 0, nil, fmt.Errorf("unknown signal %q for type %s", detailedSignal, t.Name())
+	}
+
+	var query C.GSignalQuery
+	C.g_signal_query(id, &query)
+
+	sig := &signalSignature{
+		returnType: Type(C._g_signal_query_return_type(&query)),
+		paramTypes: make([]Type, int(query.n_params)),
+	}
+	for i := range sig.paramTypes {
+		sig.paramTypes[i] = Type(C._g_signal_query_param_type(&query, C.guint(i)))
+	}
+
+	return id, detail, sig, nil
+}
+
+// signalArg converts a Go value to a Value of the given signal parameter
+// type, failing if the types are not compatible.
+func signalArg(arg interface{}, t Type) (*Value, error) {
+	if obj, ok := arg.(IObject); ok {
+		o := obj.toObject()
+		if o == nil || o.GObject == nil {
+			return nil, errors.New("nil object")
+		}
+		if !o.IsA(t) {
+			return nil, fmt.Errorf("%s is not a %s", o.TypeFromInstance().Name(), t.Name())
+		}
+
+		val, err := ValueInit(t)
+		if err != nil {
+			return nil, err
+		}
+		C.g_value_set_object(val.native(), C.gpointer(o.native()))
+		return val, nil
+	}
+
+	val, err := GValue(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	actual, _, err := val.Type()
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case actual == t:
+		return val, nil
+
+	case gobool(C.g_value_type_compatible(C.GType(actual), C.GType(t))):
+		conv, err := ValueInit(t)
+		if err != nil {
+			return nil, err
+		}
+		C.g_value_copy(val.native(), conv.native())
+		return conv, nil
+
+	case gobool(C.g_value_type_transformable(C.GType(actual), C.GType(t))):
+		conv, err := ValueInit(t)
+		if err != nil {
+			return nil, err
+		}
+		if !gobool(C.g_value_transform(val.native(), conv.native())) {
+			return nil, fmt.Errorf("cannot transform %s to %s", actual.Name(), t.Name())
+		}
+		return conv, nil
+	}
+
+	return nil, fmt.Errorf("%s is not compatible with %s", actual.Name(), t.Name())
+}
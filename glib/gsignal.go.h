@@ -0,0 +1,33 @@
+// Same copyright and license as the rest of the files in this project
+
+#ifndef __GSIGNAL_GO_H__
+#define __GSIGNAL_GO_H__
+
+#include <glib-object.h>
+#include <glib.h>
+
+extern gboolean goSignalAccumulator(GSignalInvocationHint *ihint,
+                                    GValue *return_accu,
+                                    GValue *handler_return, gpointer data);
+
+static inline guint _g_signal_newv_full(const gchar *name, GType itype,
+                                        GSignalFlags flags,
+                                        GClosure *class_closure,
+                                        gboolean has_accumulator,
+                                        gpointer accu_data, GType return_type,
+                                        guint n_params, GType *param_types) {
+  return g_signal_newv(
+      name, itype, flags, class_closure,
+      has_accumulator ? (GSignalAccumulator)goSignalAccumulator : NULL,
+      accu_data, NULL, return_type, n_params, param_types);
+}
+
+static inline GType _g_signal_query_param_type(GSignalQuery *query, guint n) {
+  return query->param_types[n] & ~G_SIGNAL_TYPE_STATIC_SCOPE;
+}
+
+static inline GType _g_signal_query_return_type(GSignalQuery *query) {
+  return query->return_type & ~G_SIGNAL_TYPE_STATIC_SCOPE;
+}
+
+#endif
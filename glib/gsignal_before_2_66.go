@@ -0,0 +1,20 @@
+// Same copyright and license as the rest of the files in this project
+
+// +build glib_2_40 glib_2_42 glib_2_44 glib_2_46 glib_2_48 glib_2_50 glib_2_52 glib_2_54 glib_2_56 glib_2_58 glib_2_60 glib_2_62 glib_2_64
+
+package glib
+
+// SignalIsValidName reports whether name is a valid signal name, following
+// the rules of g_signal_is_valid_name(), which is only available since GLib
+// 2.66: a letter, followed by letters, digits, '-' or '_'.
+func SignalIsValidName(name string) bool {
+	for i, c := range name {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		case i > 0 && (c >= '0' && c <= '9' || c == '-' || c == '_'):
+		default:
+			return false
+		}
+	}
+	return name != ""
+}
@@ -0,0 +1,18 @@
+// Same copyright and license as the rest of the files in this project
+
+// +build !glib_2_40,!glib_2_42,!glib_2_44,!glib_2_46,!glib_2_48,!glib_2_50,!glib_2_52,!glib_2_54,!glib_2_56,!glib_2_58,!glib_2_60,!glib_2_62,!glib_2_64
+
+package glib
+
+// #include <glib.h>
+// #include <glib-object.h>
+// #include <stdlib.h>
+import "C"
+import "unsafe"
+
+// SignalIsValidName is a wrapper around g_signal_is_valid_name().
+func SignalIsValidName(name string) bool {
+	cstr := C.CString(name)
+	defer C.free(unsafe.Pointer(cstr))
+	return gobool(C.g_signal_is_valid_name((*C.gchar)(cstr)))
+}
@@ -0,0 +1,106 @@
+package glib_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func TestSignalNewFull(t *testing.T) {
+	typ, err := glib.RegisterType("GotkTestSignalThing", glib.TYPE_OBJECT, &glib.TypeInfo{
+		InstanceInit: func(obj *glib.Object) *testThing {
+			return &testThing{Object: obj}
+		},
+	})
+	if err != nil {
+		t.Fatal("RegisterType failed:", err)
+	}
+
+	_, err = glib.SignalNewFull("double", typ, glib.SIGNAL_RUN_LAST,
+		func(thing *testThing, n int) int {
+			thing.counter++
+			return n * 2
+		},
+		glib.SignalAccumulatorFirstWins, glib.TYPE_INT, glib.TYPE_INT)
+	if err != nil {
+		t.Fatal("SignalNewFull failed:", err)
+	}
+
+	_, err = glib.SignalNewFull("handle", typ, glib.SIGNAL_RUN_LAST, nil,
+		glib.SignalAccumulatorTrueHandled, glib.TYPE_BOOLEAN)
+	if err != nil {
+		t.Fatal("SignalNewFull failed:", err)
+	}
+
+	obj, err := glib.ObjectNew(typ)
+	if err != nil {
+		t.Fatal("ObjectNew failed:", err)
+	}
+
+	t.Run("class closure", func(t *testing.T) {
+		ret, err := obj.Emit("double", glib.TYPE_INT, 21)
+		if err != nil {
+			t.Fatal("Emit failed:", err)
+		}
+		if ret.(int) != 42 {
+			t.Errorf("Expected 42, got %v", ret)
+		}
+
+		v, _ := obj.GoValue()
+		if v.(*testThing).counter != 1 {
+			t.Error("Expected class closure to run once")
+		}
+	})
+
+	t.Run("accumulator", func(t *testing.T) {
+		var calls int
+		obj.Connect("handle", func() bool {
+			calls++
+			return true
+		})
+		obj.Connect("handle", func() bool {
+			calls++
+			return false
+		})
+
+		ret, err := obj.Emit("handle", glib.TYPE_BOOLEAN)
+		if err != nil {
+			t.Fatal("Emit failed:", err)
+		}
+		if !ret.(bool) {
+			t.Error("Expected signal to be handled")
+		}
+		if calls != 1 {
+			t.Errorf("Expected emission to stop after 1 handler, ran %d", calls)
+		}
+	})
+
+	t.Run("type checking", func(t *testing.T) {
+		if _, err := obj.Emit("double", glib.TYPE_INT, "21"); err == nil {
+			t.Error("Expected error for string argument")
+		}
+		if _, err := obj.Emit("double", glib.TYPE_INT); err == nil {
+			t.Error("Expected error for missing argument")
+		}
+		if _, err := obj.Emit("double", glib.TYPE_STRING, 21); err == nil {
+			t.Error("Expected error for wrong return type")
+		}
+		if _, err := obj.Emit("no-such-signal", glib.TYPE_NONE); err == nil {
+			t.Error("Expected error for unknown signal")
+		}
+	})
+
+	t.Run("registration errors", func(t *testing.T) {
+		_, err := glib.SignalNewFull("1st signal", typ, glib.SIGNAL_RUN_LAST, nil, nil, glib.TYPE_NONE)
+		if err == nil || !strings.Contains(err.Error(), "invalid signal name") {
+			t.Error("Expected an invalid name error, got", err)
+		}
+
+		// GLib warns about the duplicate before failing.
+		_, err = glib.SignalNewFull("handle", typ, glib.SIGNAL_RUN_LAST, nil, nil, glib.TYPE_NONE)
+		if err == nil || !strings.Contains(err.Error(), "GotkTestSignalThing") {
+			t.Error("Expected a registration error naming the type, got", err)
+		}
+	})
+}
@@ -0,0 +1,492 @@
+package glib
+
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "gsocket.go.h"
+import "C"
+import (
+	"errors"
+	"time"
+	"unsafe"
+)
+
+func init() {
+
+	tm := []TypeMarshaler{
+		{Type(C.g_inet_address_get_type()), marshalInetAddress},
+		{Type(C.g_socket_address_get_type()), marshalSocketAddress},
+		{Type(C.g_inet_socket_address_get_type()), marshalInetSocketAddress},
+		{Type(C.g_socket_get_type()), marshalSocket},
+		{Type(C.g_socket_connection_get_type()), marshalSocketConnection},
+
+		// Enums
+		{Type(C.g_socket_family_get_type()), marshalSocketFamily},
+		{Type(C.g_socket_type_get_type()), marshalSocketType},
+		{Type(C.g_socket_protocol_get_type()), marshalSocketProtocol},
+	}
+
+	RegisterGValueMarshalers(tm)
+}
+
+/*
+ * Enums
+ */
+
+// SocketFamily is a representation of GIO's GSocketFamily.
+type SocketFamily int
+
+const (
+	SOCKET_FAMILY_INVALID SocketFamily = C.G_SOCKET_FAMILY_INVALID
+	SOCKET_FAMILY_UNIX    SocketFamily = C.G_SOCKET_FAMILY_UNIX
+	SOCKET_FAMILY_IPV4    SocketFamily = C.G_SOCKET_FAMILY_IPV4
+	SOCKET_FAMILY_IPV6    SocketFamily = C.G_SOCKET_FAMILY_IPV6
+)
+
+func marshalSocketFamily(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return SocketFamily(c), nil
+}
+
+// SocketType is a representation of GIO's GSocketType.
+type SocketType int
+
+const (
+	SOCKET_TYPE_INVALID   SocketType = C.G_SOCKET_TYPE_INVALID
+	SOCKET_TYPE_STREAM    SocketType = C.G_SOCKET_TYPE_STREAM
+	SOCKET_TYPE_DATAGRAM  SocketType = C.G_SOCKET_TYPE_DATAGRAM
+	SOCKET_TYPE_SEQPACKET SocketType = C.G_SOCKET_TYPE_SEQPACKET
+)
+
+func marshalSocketType(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return SocketType(c), nil
+}
+
+// SocketProtocol is a representation of GIO's GSocketProtocol.
+type SocketProtocol int
+
+const (
+	SOCKET_PROTOCOL_UNKNOWN SocketProtocol = C.G_SOCKET_PROTOCOL_UNKNOWN
+	SOCKET_PROTOCOL_DEFAULT SocketProtocol = C.G_SOCKET_PROTOCOL_DEFAULT
+	SOCKET_PROTOCOL_TCP     SocketProtocol = C.G_SOCKET_PROTOCOL_TCP
+	SOCKET_PROTOCOL_UDP     SocketProtocol = C.G_SOCKET_PROTOCOL_UDP
+	SOCKET_PROTOCOL_SCTP    SocketProtocol = C.G_SOCKET_PROTOCOL_SCTP
+)
+
+func marshalSocketProtocol(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return SocketProtocol(c), nil
+}
+
+/*
+ * GInetAddress
+ */
+
+// InetAddress is a representation of GIO's GInetAddress.
+// An IPv4/IPv6 address
+type InetAddress struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GInetAddress.
+func (v *InetAddress) native() *C.GInetAddress {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGInetAddress(p)
+}
+
+// Native returns a pointer to the underlying GInetAddress.
+func (v *InetAddress) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalInetAddress(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapInetAddress(obj), nil
+}
+
+func wrapInetAddress(obj *Object) *InetAddress {
+	if obj == nil {
+		return nil
+	}
+	return &InetAddress{obj}
+}
+
+// InetAddressNewFromString is a wrapper around
+// g_inet_address_new_from_string().
+func InetAddressNewFromString(str string) (*InetAddress, error) {
+	cstr := (*C.gchar)(C.CString(str))
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_inet_address_new_from_string(cstr)
+	if c == nil {
+		return nil, errors.New("invalid IP address " + str)
+	}
+	return wrapInetAddress(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// InetAddressNewLoopback is a wrapper around g_inet_address_new_loopback().
+func InetAddressNewLoopback(family SocketFamily) *InetAddress {
+	c := C.g_inet_address_new_loopback(C.GSocketFamily(family))
+	return wrapInetAddress(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// InetAddressNewAny is a wrapper around g_inet_address_new_any().
+func InetAddressNewAny(family SocketFamily) *InetAddress {
+	c := C.g_inet_address_new_any(C.GSocketFamily(family))
+	return wrapInetAddress(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// ToString is a wrapper around g_inet_address_to_string().
+func (v *InetAddress) ToString() string {
+	c := C.g_inet_address_to_string(v.native())
+	defer C.g_free(C.gpointer(c))
+	return goString(c)
+}
+
+// Equal is a wrapper around g_inet_address_equal().
+func (v *InetAddress) Equal(other *InetAddress) bool {
+	return gobool(C.g_inet_address_equal(v.native(), other.native()))
+}
+
+// GetFamily is a wrapper around g_inet_address_get_family().
+func (v *InetAddress) GetFamily() SocketFamily {
+	return SocketFamily(C.g_inet_address_get_family(v.native()))
+}
+
+// GetIsAny is a wrapper around g_inet_address_get_is_any().
+func (v *InetAddress) GetIsAny() bool {
+	return gobool(C.g_inet_address_get_is_any(v.native()))
+}
+
+// GetIsLoopback is a wrapper around g_inet_address_get_is_loopback().
+func (v *InetAddress) GetIsLoopback() bool {
+	return gobool(C.g_inet_address_get_is_loopback(v.native()))
+}
+
+/*
+ * GSocketAddress
+ */
+
+// SocketAddress is a representation of GIO's GSocketAddress.
+// Abstract base class representing endpoints for socket communication
+type SocketAddress struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GSocketAddress.
+func (v *SocketAddress) native() *C.GSocketAddress {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGSocketAddress(p)
+}
+
+// Native returns a pointer to the underlying GSocketAddress.
+func (v *SocketAddress) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalSocketAddress(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapSocketAddress(obj), nil
+}
+
+func wrapSocketAddress(obj *Object) *SocketAddress {
+	if obj == nil {
+		return nil
+	}
+	return &SocketAddress{obj}
+}
+
+// toGSocketConnectable returns the address as a GSocketConnectable, which
+// all socket addresses implement.
+func (v *SocketAddress) toGSocketConnectable() *C.GSocketConnectable {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	return C.toGSocketConnectable(unsafe.Pointer(v.GObject))
+}
+
+// GetFamily is a wrapper around g_socket_address_get_family().
+func (v *SocketAddress) GetFamily() SocketFamily {
+	return SocketFamily(C.g_socket_address_get_family(v.native()))
+}
+
+// ToInetSocketAddress returns the address as an InetSocketAddress, or nil
+// if it is not one, like the address of a unix socket.
+func (v *SocketAddress) ToInetSocketAddress() *InetSocketAddress {
+	if v == nil || !v.IsA(Type(C.g_inet_socket_address_get_type())) {
+		return nil
+	}
+	return &InetSocketAddress{v}
+}
+
+/*
+ * GInetSocketAddress
+ */
+
+// InetSocketAddress is a representation of GIO's GInetSocketAddress.
+// Internet GSocketAddress
+type InetSocketAddress struct {
+	*SocketAddress
+}
+
+// native returns a pointer to the underlying GInetSocketAddress.
+func (v *InetSocketAddress) native() *C.GInetSocketAddress {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGInetSocketAddress(p)
+}
+
+// Native returns a pointer to the underlying GInetSocketAddress.
+func (v *InetSocketAddress) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalInetSocketAddress(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapInetSocketAddress(obj), nil
+}
+
+func wrapInetSocketAddress(obj *Object) *InetSocketAddress {
+	if obj == nil {
+		return nil
+	}
+	return &InetSocketAddress{wrapSocketAddress(obj)}
+}
+
+// InetSocketAddressNew is a wrapper around g_inet_socket_address_new().
+func InetSocketAddressNew(address *InetAddress, port uint16) *InetSocketAddress {
+	c := C.g_inet_socket_address_new(address.native(), C.guint16(port))
+	return wrapInetSocketAddress(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// InetSocketAddressNewFromString is a wrapper around
+// g_inet_socket_address_new_from_string(). address must be an IP address,
+// host names are not resolved.
+func InetSocketAddressNewFromString(address string, port uint) (*InetSocketAddress, error) {
+	cstr := C.CString(address)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_inet_socket_address_new_from_string(cstr, C.guint(port))
+	if c == nil {
+		return nil, errors.New("invalid IP address " + address)
+	}
+	return wrapInetSocketAddress(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// GetAddress is a wrapper around g_inet_socket_address_get_address().
+func (v *InetSocketAddress) GetAddress() *InetAddress {
+	c := C.g_inet_socket_address_get_address(v.native())
+	return wrapInetAddress(Take(unsafe.Pointer(c)))
+}
+
+// GetPort is a wrapper around g_inet_socket_address_get_port().
+func (v *InetSocketAddress) GetPort() uint16 {
+	return uint16(C.g_inet_socket_address_get_port(v.native()))
+}
+
+/*
+ * GSocket
+ */
+
+// Socket is a representation of GIO's GSocket.
+// Low-level socket object
+type Socket struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GSocket.
+func (v *Socket) native() *C.GSocket {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGSocket(p)
+}
+
+// Native returns a pointer to the underlying GSocket.
+func (v *Socket) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalSocket(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapSocket(obj), nil
+}
+
+func wrapSocket(obj *Object) *Socket {
+	if obj == nil {
+		return nil
+	}
+	return &Socket{obj}
+}
+
+// GetFd is a wrapper around g_socket_get_fd().
+func (v *Socket) GetFd() int {
+	return int(C.g_socket_get_fd(v.native()))
+}
+
+// GetFamily is a wrapper around g_socket_get_family().
+func (v *Socket) GetFamily() SocketFamily {
+	return SocketFamily(C.g_socket_get_family(v.native()))
+}
+
+// GetSocketType is a wrapper around g_socket_get_socket_type().
+func (v *Socket) GetSocketType() SocketType {
+	return SocketType(C.g_socket_get_socket_type(v.native()))
+}
+
+// GetProtocol is a wrapper around g_socket_get_protocol().
+func (v *Socket) GetProtocol() SocketProtocol {
+	return SocketProtocol(C.g_socket_get_protocol(v.native()))
+}
+
+// socketAddress converts the result of the functions returning a
+// GSocketAddress.
+func socketAddress(c *C.GSocketAddress, gerr *C.GError) (*SocketAddress, error) {
+	if c == nil {
+		return nil, takeError(gerr)
+	}
+	return wrapSocketAddress(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// GetLocalAddress is a wrapper around g_socket_get_local_address().
+func (v *Socket) GetLocalAddress() (*SocketAddress, error) {
+	var gerr *C.GError
+	c := C.g_socket_get_local_address(v.native(), &gerr)
+	return socketAddress(c, gerr)
+}
+
+// GetRemoteAddress is a wrapper around g_socket_get_remote_address().
+func (v *Socket) GetRemoteAddress() (*SocketAddress, error) {
+	var gerr *C.GError
+	c := C.g_socket_get_remote_address(v.native(), &gerr)
+	return socketAddress(c, gerr)
+}
+
+// GetBlocking is a wrapper around g_socket_get_blocking().
+func (v *Socket) GetBlocking() bool {
+	return gobool(C.g_socket_get_blocking(v.native()))
+}
+
+// SetBlocking is a wrapper around g_socket_set_blocking().
+func (v *Socket) SetBlocking(blocking bool) {
+	C.g_socket_set_blocking(v.native(), gbool(blocking))
+}
+
+// GetKeepalive is a wrapper around g_socket_get_keepalive().
+func (v *Socket) GetKeepalive() bool {
+	return gobool(C.g_socket_get_keepalive(v.native()))
+}
+
+// SetKeepalive is a wrapper around g_socket_set_keepalive().
+func (v *Socket) SetKeepalive(keepalive bool) {
+	C.g_socket_set_keepalive(v.native(), gbool(keepalive))
+}
+
+// GetTimeout is a wrapper around g_socket_get_timeout(). A timeout of 0
+// means that operations never time out.
+func (v *Socket) GetTimeout() time.Duration {
+	return time.Duration(C.g_socket_get_timeout(v.native())) * time.Second
+}
+
+// SetTimeout is a wrapper around g_socket_set_timeout(). The timeout is
+// rounded down to seconds.
+func (v *Socket) SetTimeout(timeout time.Duration) {
+	C.g_socket_set_timeout(v.native(), C.guint(timeout/time.Second))
+}
+
+// IsConnected is a wrapper around g_socket_is_connected().
+func (v *Socket) IsConnected() bool {
+	return gobool(C.g_socket_is_connected(v.native()))
+}
+
+// IsClosed is a wrapper around g_socket_is_closed().
+func (v *Socket) IsClosed() bool {
+	return gobool(C.g_socket_is_closed(v.native()))
+}
+
+// Close is a wrapper around g_socket_close().
+func (v *Socket) Close() error {
+	var gerr *C.GError
+	if !gobool(C.g_socket_close(v.native(), &gerr)) {
+		return takeError(gerr)
+	}
+	return nil
+}
+
+/*
+ * GSocketConnection
+ */
+
+// SocketConnection is a representation of GIO's GSocketConnection.
+// A socket connection, its input and output streams are given by
+// GetInputStream and GetOutputStream.
+type SocketConnection struct {
+	*IOStream
+}
+
+// native returns a pointer to the underlying GSocketConnection.
+func (v *SocketConnection) native() *C.GSocketConnection {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGSocketConnection(p)
+}
+
+// Native returns a pointer to the underlying GSocketConnection.
+func (v *SocketConnection) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalSocketConnection(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapSocketConnection(obj), nil
+}
+
+func wrapSocketConnection(obj *Object) *SocketConnection {
+	if obj == nil {
+		return nil
+	}
+	return &SocketConnection{wrapIOStream(obj)}
+}
+
+// GetSocket is a wrapper around g_socket_connection_get_socket().
+func (v *SocketConnection) GetSocket() *Socket {
+	c := C.g_socket_connection_get_socket(v.native())
+	return wrapSocket(Take(unsafe.Pointer(c)))
+}
+
+// GetLocalAddress is a wrapper around g_socket_connection_get_local_address().
+func (v *SocketConnection) GetLocalAddress() (*SocketAddress, error) {
+	var gerr *C.GError
+	c := C.g_socket_connection_get_local_address(v.native(), &gerr)
+	return socketAddress(c, gerr)
+}
+
+// GetRemoteAddress is a wrapper around
+// g_socket_connection_get_remote_address().
+func (v *SocketConnection) GetRemoteAddress() (*SocketAddress, error) {
+	var gerr *C.GError
+	c := C.g_socket_connection_get_remote_address(v.native(), &gerr)
+	return socketAddress(c, gerr)
+}
+
+// IsConnected is a wrapper around g_socket_connection_is_connected().
+func (v *SocketConnection) IsConnected() bool {
+	return gobool(C.g_socket_connection_is_connected(v.native()))
+}
@@ -0,0 +1,70 @@
+#pragma once
+
+#include <gio/gio.h>
+#include <glib.h>
+#include <stdlib.h>
+
+static GInetAddress *toGInetAddress(void *p) { return (G_INET_ADDRESS(p)); }
+
+static GSocketAddress *toGSocketAddress(void *p) {
+  return (G_SOCKET_ADDRESS(p));
+}
+
+static GInetSocketAddress *toGInetSocketAddress(void *p) {
+  return (G_INET_SOCKET_ADDRESS(p));
+}
+
+static GSocketConnectable *toGSocketConnectable(void *p) {
+  return (G_SOCKET_CONNECTABLE(p));
+}
+
+static GSocket *toGSocket(void *p) { return (G_SOCKET(p)); }
+
+static GSocketConnection *toGSocketConnection(void *p) {
+  return (G_SOCKET_CONNECTION(p));
+}
+
+static GSocketClient *toGSocketClient(void *p) { return (G_SOCKET_CLIENT(p)); }
+
+static GSocketListener *toGSocketListener(void *p) {
+  return (G_SOCKET_LISTENER(p));
+}
+
+static GSocketService *toGSocketService(void *p) {
+  return (G_SOCKET_SERVICE(p));
+}
+
+static GThreadedSocketService *toGThreadedSocketService(void *p) {
+  return (G_THREADED_SOCKET_SERVICE(p));
+}
+
+/*
+ * GAsyncReadyCallback
+ */
+
+extern void goAsyncReadyCallbacks(GObject *source_object, GAsyncResult *res,
+                                  gpointer user_data);
+
+static inline void _g_socket_client_connect_async(
+    GSocketClient *client, GSocketConnectable *connectable,
+    GCancellable *cancellable, gpointer user_data) {
+  g_socket_client_connect_async(client, connectable, cancellable,
+                                (GAsyncReadyCallback)(goAsyncReadyCallbacks),
+                                user_data);
+}
+
+static inline void _g_socket_client_connect_to_host_async(
+    GSocketClient *client, const gchar *host_and_port, guint16 default_port,
+    GCancellable *cancellable, gpointer user_data) {
+  g_socket_client_connect_to_host_async(
+      client, host_and_port, default_port, cancellable,
+      (GAsyncReadyCallback)(goAsyncReadyCallbacks), user_data);
+}
+
+static inline void _g_socket_listener_accept_async(GSocketListener *listener,
+                                                   GCancellable *cancellable,
+                                                   gpointer user_data) {
+  g_socket_listener_accept_async(listener, cancellable,
+                                 (GAsyncReadyCallback)(goAsyncReadyCallbacks),
+                                 user_data);
+}
@@ -0,0 +1,148 @@
+package glib_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+// runMainLoop runs mainLoop until it is quit, failing the test if that
+// takes more than 5 seconds.
+func runMainLoop(t *testing.T, mainLoop *glib.MainLoop) {
+	timeout := glib.TimeoutAdd(5000, func() bool {
+		t.Error("Timed out")
+		mainLoop.Quit()
+		return false
+	})
+	mainLoop.Run()
+	glib.SourceRemove(timeout)
+}
+
+func TestSocketService(t *testing.T) {
+	service := glib.SocketServiceNew()
+	defer service.Stop()
+	port, err := service.AddAnyInetPort(nil)
+	if err != nil {
+		t.Fatal("AddAnyInetPort failed:", err)
+	}
+
+	mainLoop := glib.MainLoopNew(glib.MainContextDefault(), false)
+	pending := 2
+	done := func() {
+		if pending--; pending == 0 {
+			mainLoop.Quit()
+		}
+	}
+
+	var serverConn *glib.SocketConnection
+	service.ConnectIncoming(func(_ *glib.SocketService, conn *glib.SocketConnection, _ *glib.Object) bool {
+		serverConn = conn
+		conn.GetOutputStream().AsWriter(nil).Write([]byte("hello\n"))
+		done()
+		return true
+	})
+
+	var (
+		clientConn *glib.SocketConnection
+		clientErr  error
+	)
+	client := glib.SocketClientNew()
+	client.SetEnableProxy(false)
+	client.ConnectToHostAsync(fmt.Sprintf("127.0.0.1:%d", port), 0, nil, func(_ *glib.Object, res *glib.AsyncResult) {
+		clientConn, clientErr = client.ConnectToHostFinish(res)
+		done()
+	})
+	runMainLoop(t, mainLoop)
+
+	if clientErr != nil {
+		t.Fatal("ConnectToHost failed:", clientErr)
+	}
+	if serverConn == nil {
+		t.Fatal("Expected an incoming connection")
+	}
+
+	in := glib.DataInputStreamNew(clientConn.GetInputStream())
+	if line, err := in.ReadLine(nil); err != nil || line != "hello" {
+		t.Errorf("ReadLine returned %q, %v", line, err)
+	}
+
+	remote, err := clientConn.GetRemoteAddress()
+	if err != nil {
+		t.Fatal("GetRemoteAddress failed:", err)
+	}
+	addr := remote.ToInetSocketAddress()
+	if addr == nil || addr.GetPort() != port || !addr.GetAddress().GetIsLoopback() {
+		t.Error("Unexpected remote address")
+	}
+	if !clientConn.IsConnected() || clientConn.GetSocket().GetSocketType() != glib.SOCKET_TYPE_STREAM {
+		t.Error("Expected a connected stream socket")
+	}
+	serverConn.Close(nil)
+	clientConn.Close(nil)
+}
+
+func TestThreadedSocketService(t *testing.T) {
+	service := glib.ThreadedSocketServiceNew(-1)
+	defer service.Stop()
+	port, err := service.AddAnyInetPort(nil)
+	if err != nil {
+		t.Fatal("AddAnyInetPort failed:", err)
+	}
+
+	// Echo the first line in upper case.
+	service.ConnectRun(func(_ *glib.ThreadedSocketService, conn *glib.SocketConnection, _ *glib.Object) bool {
+		line, err := glib.DataInputStreamNew(conn.GetInputStream()).ReadLine(nil)
+		if err == nil {
+			conn.GetOutputStream().AsWriter(nil).Write([]byte(strings.ToUpper(line) + "\n"))
+		}
+		return true
+	})
+
+	// Connections are accepted by the main loop, so the client must not
+	// block it.
+	mainLoop := glib.MainLoopNew(glib.MainContextDefault(), false)
+	var (
+		reply     string
+		clientErr error
+	)
+	go func() {
+		defer glib.IdleAdd(mainLoop.Quit)
+
+		conn, err := glib.SocketClientNew().ConnectToHost("127.0.0.1", port, nil)
+		if err != nil {
+			clientErr = err
+			return
+		}
+		defer conn.Close(nil)
+		conn.GetOutputStream().AsWriter(nil).Write([]byte("ping\n"))
+		reply, clientErr = glib.DataInputStreamNew(conn.GetInputStream()).ReadLine(nil)
+	}()
+	runMainLoop(t, mainLoop)
+
+	if clientErr != nil || reply != "PING" {
+		t.Errorf("Unexpected reply %q, %v", reply, clientErr)
+	}
+}
+
+func TestInetAddress(t *testing.T) {
+	addr, err := glib.InetAddressNewFromString("::1")
+	if err != nil {
+		t.Fatal("InetAddressNewFromString failed:", err)
+	}
+	if addr.GetFamily() != glib.SOCKET_FAMILY_IPV6 || !addr.GetIsLoopback() || addr.ToString() != "::1" {
+		t.Error("Unexpected address", addr.ToString())
+	}
+	if !addr.Equal(glib.InetAddressNewLoopback(glib.SOCKET_FAMILY_IPV6)) {
+		t.Error("Expected ::1 to be the IPv6 loopback address")
+	}
+	if _, err := glib.InetAddressNewFromString("localhost"); err == nil {
+		t.Error("Expected an error for a host name")
+	}
+
+	sa := glib.InetSocketAddressNew(glib.InetAddressNewAny(glib.SOCKET_FAMILY_IPV4), 8080)
+	if sa.GetPort() != 8080 || !sa.GetAddress().GetIsAny() || sa.GetFamily() != glib.SOCKET_FAMILY_IPV4 {
+		t.Error("Unexpected socket address")
+	}
+}
@@ -0,0 +1,402 @@
+package glib
+
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "gsocket.go.h"
+import "C"
+import (
+	"time"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/internal/callback"
+)
+
+func init() {
+
+	tm := []TypeMarshaler{
+		{Type(C.g_socket_client_get_type()), marshalSocketClient},
+		{Type(C.g_socket_listener_get_type()), marshalSocketListener},
+		{Type(C.g_socket_service_get_type()), marshalSocketService},
+		{Type(C.g_threaded_socket_service_get_type()), marshalThreadedSocketService},
+	}
+
+	RegisterGValueMarshalers(tm)
+}
+
+// socketConnection converts the result of the functions returning a
+// GSocketConnection.
+func socketConnection(c *C.GSocketConnection, gerr *C.GError) (*SocketConnection, error) {
+	if c == nil {
+		return nil, takeError(gerr)
+	}
+	return wrapSocketConnection(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+/*
+ * GSocketClient
+ */
+
+// SocketClient is a representation of GIO's GSocketClient.
+// Helper for connecting to a network service
+type SocketClient struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GSocketClient.
+func (v *SocketClient) native() *C.GSocketClient {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGSocketClient(p)
+}
+
+// Native returns a pointer to the underlying GSocketClient.
+func (v *SocketClient) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalSocketClient(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapSocketClient(obj), nil
+}
+
+func wrapSocketClient(obj *Object) *SocketClient {
+	return &SocketClient{obj}
+}
+
+// SocketClientNew is a wrapper around g_socket_client_new().
+func SocketClientNew() *SocketClient {
+	c := C.g_socket_client_new()
+	return wrapSocketClient(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// GetFamily is a wrapper around g_socket_client_get_family().
+func (v *SocketClient) GetFamily() SocketFamily {
+	return SocketFamily(C.g_socket_client_get_family(v.native()))
+}
+
+// SetFamily is a wrapper around g_socket_client_set_family().
+// SOCKET_FAMILY_INVALID allows any family.
+func (v *SocketClient) SetFamily(family SocketFamily) {
+	C.g_socket_client_set_family(v.native(), C.GSocketFamily(family))
+}
+
+// GetTimeout is a wrapper around g_socket_client_get_timeout().
+func (v *SocketClient) GetTimeout() time.Duration {
+	return time.Duration(C.g_socket_client_get_timeout(v.native())) * time.Second
+}
+
+// SetTimeout is a wrapper around g_socket_client_set_timeout(). The timeout
+// is rounded down to seconds, 0 means that operations never time out.
+func (v *SocketClient) SetTimeout(timeout time.Duration) {
+	C.g_socket_client_set_timeout(v.native(), C.guint(timeout/time.Second))
+}
+
+// GetEnableProxy is a wrapper around g_socket_client_get_enable_proxy().
+func (v *SocketClient) GetEnableProxy() bool {
+	return gobool(C.g_socket_client_get_enable_proxy(v.native()))
+}
+
+// SetEnableProxy is a wrapper around g_socket_client_set_enable_proxy().
+func (v *SocketClient) SetEnableProxy(enable bool) {
+	C.g_socket_client_set_enable_proxy(v.native(), gbool(enable))
+}
+
+// Connect is a wrapper around g_socket_client_connect().
+func (v *SocketClient) Connect(address *SocketAddress, cancellable *Cancellable) (*SocketConnection, error) {
+	var gerr *C.GError
+	c := C.g_socket_client_connect(v.native(), address.toGSocketConnectable(), cancellable.native(), &gerr)
+	return socketConnection(c, gerr)
+}
+
+// ConnectAsync is a wrapper around g_socket_client_connect_async().
+func (v *SocketClient) ConnectAsync(address *SocketAddress, cancellable *Cancellable, fn AsyncReadyCallback) {
+	C._g_socket_client_connect_async(v.native(), address.toGSocketConnectable(), cancellable.native(),
+		C.gpointer(callback.Assign(fn)))
+}
+
+// ConnectFinish is a wrapper around g_socket_client_connect_finish().
+func (v *SocketClient) ConnectFinish(result *AsyncResult) (*SocketConnection, error) {
+	var gerr *C.GError
+	c := C.g_socket_client_connect_finish(v.native(), result.native(), &gerr)
+	return socketConnection(c, gerr)
+}
+
+// ConnectToHost is a wrapper around g_socket_client_connect_to_host().
+// hostAndPort is a host name or IP address, optionally followed by a port,
+// like "localhost:8080" or "[::1]:8080". defaultPort is used if it has no
+// port.
+func (v *SocketClient) ConnectToHost(hostAndPort string, defaultPort uint16, cancellable *Cancellable) (*SocketConnection, error) {
+	cstr := (*C.gchar)(C.CString(hostAndPort))
+	defer C.free(unsafe.Pointer(cstr))
+
+	var gerr *C.GError
+	c := C.g_socket_client_connect_to_host(v.native(), cstr, C.guint16(defaultPort), cancellable.native(), &gerr)
+	return socketConnection(c, gerr)
+}
+
+// ConnectToHostAsync is a wrapper around
+// g_socket_client_connect_to_host_async().
+func (v *SocketClient) ConnectToHostAsync(hostAndPort string, defaultPort uint16, cancellable *Cancellable, fn AsyncReadyCallback) {
+	cstr := (*C.gchar)(C.CString(hostAndPort))
+	defer C.free(unsafe.Pointer(cstr))
+
+	C._g_socket_client_connect_to_host_async(v.native(), cstr, C.guint16(defaultPort), cancellable.native(),
+		C.gpointer(callback.Assign(fn)))
+}
+
+// ConnectToHostFinish is a wrapper around
+// g_socket_client_connect_to_host_finish().
+func (v *SocketClient) ConnectToHostFinish(result *AsyncResult) (*SocketConnection, error) {
+	var gerr *C.GError
+	c := C.g_socket_client_connect_to_host_finish(v.native(), result.native(), &gerr)
+	return socketConnection(c, gerr)
+}
+
+/*
+ * GSocketListener
+ */
+
+// SocketListener is a representation of GIO's GSocketListener.
+// Helper for accepting network client connections
+type SocketListener struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GSocketListener.
+func (v *SocketListener) native() *C.GSocketListener {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGSocketListener(p)
+}
+
+// Native returns a pointer to the underlying GSocketListener.
+func (v *SocketListener) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalSocketListener(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapSocketListener(obj), nil
+}
+
+func wrapSocketListener(obj *Object) *SocketListener {
+	return &SocketListener{obj}
+}
+
+// SocketListenerNew is a wrapper around g_socket_listener_new().
+func SocketListenerNew() *SocketListener {
+	c := C.g_socket_listener_new()
+	return wrapSocketListener(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// SetBacklog is a wrapper around g_socket_listener_set_backlog().
+func (v *SocketListener) SetBacklog(listenBacklog int) {
+	C.g_socket_listener_set_backlog(v.native(), C.int(listenBacklog))
+}
+
+// AddAddress is a wrapper around g_socket_listener_add_address(). It
+// returns the address actually listened on, which differs from address if
+// its port is 0.
+func (v *SocketListener) AddAddress(address *SocketAddress, socketType SocketType, protocol SocketProtocol,
+	sourceObject *Object) (*SocketAddress, error) {
+	var effective *C.GSocketAddress
+	var gerr *C.GError
+	ok := gobool(C.g_socket_listener_add_address(v.native(), address.native(), C.GSocketType(socketType),
+		C.GSocketProtocol(protocol), sourceObject.native(), &effective, &gerr))
+	if !ok {
+		return nil, takeError(gerr)
+	}
+	return wrapSocketAddress(AssumeOwnership(unsafe.Pointer(effective))), nil
+}
+
+// AddInetPort is a wrapper around g_socket_listener_add_inet_port(). It
+// listens on port of all the IPv4 and IPv6 addresses.
+func (v *SocketListener) AddInetPort(port uint16, sourceObject *Object) error {
+	var gerr *C.GError
+	ok := gobool(C.g_socket_listener_add_inet_port(v.native(), C.guint16(port), sourceObject.native(), &gerr))
+	if !ok {
+		return takeError(gerr)
+	}
+	return nil
+}
+
+// AddAnyInetPort is a wrapper around g_socket_listener_add_any_inet_port().
+// It returns the port chosen.
+func (v *SocketListener) AddAnyInetPort(sourceObject *Object) (uint16, error) {
+	var gerr *C.GError
+	c := C.g_socket_listener_add_any_inet_port(v.native(), sourceObject.native(), &gerr)
+	if c == 0 {
+		return 0, takeError(gerr)
+	}
+	return uint16(c), nil
+}
+
+// Accept is a wrapper around g_socket_listener_accept(). sourceObject is the
+// object given when adding the address the connection was accepted on.
+func (v *SocketListener) Accept(cancellable *Cancellable) (connection *SocketConnection, sourceObject *Object, err error) {
+	var source *C.GObject
+	var gerr *C.GError
+	c := C.g_socket_listener_accept(v.native(), &source, cancellable.native(), &gerr)
+	connection, err = socketConnection(c, gerr)
+	if err != nil {
+		return nil, nil, err
+	}
+	return connection, Take(unsafe.Pointer(source)), nil
+}
+
+// AcceptAsync is a wrapper around g_socket_listener_accept_async().
+func (v *SocketListener) AcceptAsync(cancellable *Cancellable, fn AsyncReadyCallback) {
+	C._g_socket_listener_accept_async(v.native(), cancellable.native(), C.gpointer(callback.Assign(fn)))
+}
+
+// AcceptFinish is a wrapper around g_socket_listener_accept_finish(). It
+// returns the same values as Accept.
+func (v *SocketListener) AcceptFinish(result *AsyncResult) (connection *SocketConnection, sourceObject *Object, err error) {
+	var source *C.GObject
+	var gerr *C.GError
+	c := C.g_socket_listener_accept_finish(v.native(), result.native(), &source, &gerr)
+	connection, err = socketConnection(c, gerr)
+	if err != nil {
+		return nil, nil, err
+	}
+	return connection, Take(unsafe.Pointer(source)), nil
+}
+
+// Close is a wrapper around g_socket_listener_close().
+func (v *SocketListener) Close() {
+	C.g_socket_listener_close(v.native())
+}
+
+/*
+ * GSocketService
+ */
+
+// SocketService is a representation of GIO's GSocketService. Its
+// "incoming" signal is emitted in the main loop when a connection is
+// accepted, see ConnectIncoming.
+type SocketService struct {
+	*SocketListener
+}
+
+// native returns a pointer to the underlying GSocketService.
+func (v *SocketService) native() *C.GSocketService {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGSocketService(p)
+}
+
+// Native returns a pointer to the underlying GSocketService.
+func (v *SocketService) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalSocketService(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapSocketService(obj), nil
+}
+
+func wrapSocketService(obj *Object) *SocketService {
+	return &SocketService{wrapSocketListener(obj)}
+}
+
+// SocketServiceNew is a wrapper around g_socket_service_new(). The service
+// is active once created, addresses are added with the SocketListener
+// methods.
+func SocketServiceNew() *SocketService {
+	c := C.g_socket_service_new()
+	return wrapSocketService(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// Start is a wrapper around g_socket_service_start().
+func (v *SocketService) Start() {
+	C.g_socket_service_start(v.native())
+}
+
+// Stop is a wrapper around g_socket_service_stop().
+func (v *SocketService) Stop() {
+	C.g_socket_service_stop(v.native())
+}
+
+// IsActive is a wrapper around g_socket_service_is_active().
+func (v *SocketService) IsActive() bool {
+	return gobool(C.g_socket_service_is_active(v.native()))
+}
+
+// SocketServiceIncomingCallback is the callback of the "incoming" signal of
+// a SocketService. It returns true to stop other handlers from being
+// called. The connection stays open as long as a reference to it is kept.
+type SocketServiceIncomingCallback func(service *SocketService, connection *SocketConnection, sourceObject *Object) bool
+
+// ConnectIncoming connects f to the "incoming" signal of the SocketService.
+func (v *SocketService) ConnectIncoming(f SocketServiceIncomingCallback) SignalHandle {
+	return v.ConnectMarshal("incoming", func(ret *Value, p []Value) {
+		ret.SetBool(f(wrapSocketService(p[0].GetObject()), wrapSocketConnection(p[1].GetObject()), p[2].GetObject()))
+	})
+}
+
+/*
+ * GThreadedSocketService
+ */
+
+// ThreadedSocketService is a representation of GIO's
+// GThreadedSocketService. Unlike with SocketService, each connection is
+// handled in a worker thread by the "run" signal, see ConnectRun.
+type ThreadedSocketService struct {
+	*SocketService
+}
+
+// native returns a pointer to the underlying GThreadedSocketService.
+func (v *ThreadedSocketService) native() *C.GThreadedSocketService {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGThreadedSocketService(p)
+}
+
+// Native returns a pointer to the underlying GThreadedSocketService.
+func (v *ThreadedSocketService) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalThreadedSocketService(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapThreadedSocketService(obj), nil
+}
+
+func wrapThreadedSocketService(obj *Object) *ThreadedSocketService {
+	return &ThreadedSocketService{wrapSocketService(obj)}
+}
+
+// ThreadedSocketServiceNew is a wrapper around
+// g_threaded_socket_service_new(). A maxThreads of -1 allows any number of
+// connections to be handled at the same time.
+func ThreadedSocketServiceNew(maxThreads int) *ThreadedSocketService {
+	c := C.g_threaded_socket_service_new(C.int(maxThreads))
+	return wrapThreadedSocketService(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// ThreadedSocketServiceRunCallback is the callback of the "run" signal of a
+// ThreadedSocketService. It may block, the connection is closed once it
+// returns. It returns true to stop other handlers from being called.
+type ThreadedSocketServiceRunCallback func(service *ThreadedSocketService, connection *SocketConnection, sourceObject *Object) bool
+
+// ConnectRun connects f to the "run" signal of the ThreadedSocketService.
+// f is called in a worker thread, so it must not call GTK functions
+// directly.
+func (v *ThreadedSocketService) ConnectRun(f ThreadedSocketServiceRunCallback) SignalHandle {
+	return v.ConnectMarshal("run", func(ret *Value, p []Value) {
+		ret.SetBool(f(wrapThreadedSocketService(p[0].GetObject()), wrapSocketConnection(p[1].GetObject()), p[2].GetObject()))
+	})
+}
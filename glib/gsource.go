@@ -4,7 +4,39 @@ package glib
 // #include <glib.h>
 // #include <glib-object.h>
 // #include "glib.go.h"
+// #include "gsource.go.h"
 import "C"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/internal/callback"
+	"github.com/gotk3/gotk3/internal/closure"
+)
+
+func init() {
+	tm := []TypeMarshaler{
+		// Enums
+		{Type(C.g_io_condition_get_type()), marshalIOCondition},
+	}
+	RegisterGValueMarshalers(tm)
+}
+
+// IOCondition is a representation of GLib's GIOCondition.
+type IOCondition int
+
+const (
+	IO_IN   IOCondition = C.G_IO_IN
+	IO_OUT  IOCondition = C.G_IO_OUT
+	IO_PRI  IOCondition = C.G_IO_PRI
+	IO_ERR  IOCondition = C.G_IO_ERR
+	IO_HUP  IOCondition = C.G_IO_HUP
+	IO_NVAL IOCondition = C.G_IO_NVAL
+)
+
+func marshalIOCondition(p uintptr) (interface{}, error) {
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
+	return IOCondition(c), nil
+}
 
 type Source C.GSource
 
@@ -29,3 +61,164 @@ func MainCurrentSource() *Source {
 	}
 	return (*Source)(c)
 }
+
+// Attach is a wrapper around g_source_attach(). The context keeps a
+// reference to the source until it is destroyed, so the reference returned
+// by the functions creating a source may be released with Unref once it is
+// attached.
+func (v *Source) Attach(context *MainContext) SourceHandle {
+	return SourceHandle(C.g_source_attach(v.native(), context.native()))
+}
+
+// GetContext is a wrapper around g_source_get_context().
+func (v *Source) GetContext() *MainContext {
+	c := C.g_source_get_context(v.native())
+	if c == nil {
+		return nil
+	}
+	return (*MainContext)(c)
+}
+
+// GetID is a wrapper around g_source_get_id(). The source must be attached.
+func (v *Source) GetID() SourceHandle {
+	return SourceHandle(C.g_source_get_id(v.native()))
+}
+
+// GetPriority is a wrapper around g_source_get_priority().
+func (v *Source) GetPriority() Priority {
+	return Priority(C.g_source_get_priority(v.native()))
+}
+
+// SetPriority is a wrapper around g_source_set_priority().
+func (v *Source) SetPriority(priority Priority) {
+	C.g_source_set_priority(v.native(), C.gint(priority))
+}
+
+// GetReadyTime is a wrapper around g_source_get_ready_time().
+func (v *Source) GetReadyTime() int64 {
+	return int64(C.g_source_get_ready_time(v.native()))
+}
+
+// SetReadyTime is a wrapper around g_source_set_ready_time(). The source is
+// dispatched once the monotonic time, as returned by GetMonotonicTime,
+// reaches readyTime in microseconds. A readyTime of 0 makes it ready right
+// away, and -1 never.
+func (v *Source) SetReadyTime(readyTime int64) {
+	C.g_source_set_ready_time(v.native(), C.gint64(readyTime))
+}
+
+// GetTime is a wrapper around g_source_get_time(). It returns the
+// monotonic time of the current iteration of the main context, in
+// microseconds.
+func (v *Source) GetTime() int64 {
+	return int64(C.g_source_get_time(v.native()))
+}
+
+// GetMonotonicTime is a wrapper around g_get_monotonic_time().
+func GetMonotonicTime() int64 {
+	return int64(C.g_get_monotonic_time())
+}
+
+// sourceNew sets f as the callback of a new source.
+func sourceNew(c *C.GSource, f interface{}) *Source {
+	fs := closure.NewIdleFuncStack(f, 2)
+	id := C.gpointer(callback.Assign(fs))
+	C.g_source_set_callback(c, _sourceFunc, id, _removeSourceFunc)
+	return (*Source)(c)
+}
+
+// IdleSourceNew is a wrapper around g_idle_source_new(). It calls f as
+// IdleAdd does, in the main context the source is attached to with
+// Attach. The reference returned must be released with Unref.
+func IdleSourceNew(f interface{}) *Source {
+	return sourceNew(C.g_idle_source_new(), f)
+}
+
+// TimeoutSourceNew is a wrapper around g_timeout_source_new(). It calls f
+// as TimeoutAdd does, in the main context the source is attached to with
+// Attach. The reference returned must be released with Unref.
+func TimeoutSourceNew(milliseconds uint, f interface{}) *Source {
+	return sourceNew(C.g_timeout_source_new(C.guint(milliseconds)), f)
+}
+
+// TimeoutSecondsSourceNew is a wrapper around
+// g_timeout_source_new_seconds(). It is like TimeoutSourceNew, except with
+// seconds granularity.
+func TimeoutSecondsSourceNew(seconds uint, f interface{}) *Source {
+	return sourceNew(C.g_timeout_source_new_seconds(C.guint(seconds)), f)
+}
+
+/*
+ * GSource implemented in Go
+ */
+
+// SourceFuncs holds the functions of a source implemented in Go, see
+// SourceNew. They are called in the thread running the main context the
+// source is attached to.
+type SourceFuncs struct {
+	// Prepare is called before polling. It returns true if the source is
+	// ready to be dispatched, otherwise the maximum time in milliseconds
+	// before polling again, -1 for no limit. A nil Prepare never makes the
+	// source ready by itself.
+	Prepare func(source *Source) (ready bool, timeout int)
+
+	// Check is called after polling. It returns true if the source is
+	// ready to be dispatched. A nil Check never makes the source ready.
+	Check func(source *Source) bool
+
+	// Dispatch is called when the source is ready. It returns false to
+	// destroy the source.
+	Dispatch func(source *Source) bool
+
+	// Finalize is called once the source is freed, it may be nil.
+	Finalize func(source *Source)
+}
+
+// SourceNew is a wrapper around g_source_new() creating a source
+// implemented by funcs. The source must be attached to a main context with
+// Attach, and the reference returned released with Unref. Other goroutines
+// can make the main context check the source again with MainContext.Wakeup.
+func SourceNew(funcs *SourceFuncs) *Source {
+	id := callback.Assign(funcs)
+	c := C._gotk_source_new(C.guint(id))
+	return (*Source)(c)
+}
+
+//export goSourcePrepare
+func goSourcePrepare(source *C.GSource, id C.guint, timeout *C.gint) C.gboolean {
+	funcs := callback.Get(uintptr(id)).(*SourceFuncs)
+	*timeout = -1
+	if funcs.Prepare == nil {
+		return gbool(false)
+	}
+	ready, t := funcs.Prepare((*Source)(source))
+	*timeout = C.gint(t)
+	return gbool(ready)
+}
+
+//export goSourceCheck
+func goSourceCheck(source *C.GSource, id C.guint) C.gboolean {
+	funcs := callback.Get(uintptr(id)).(*SourceFuncs)
+	if funcs.Check == nil {
+		return gbool(false)
+	}
+	return gbool(funcs.Check((*Source)(source)))
+}
+
+//export goSourceDispatch
+func goSourceDispatch(source *C.GSource, id C.guint) C.gboolean {
+	funcs := callback.Get(uintptr(id)).(*SourceFuncs)
+	if funcs.Dispatch == nil {
+		return gbool(true)
+	}
+	return gbool(funcs.Dispatch((*Source)(source)))
+}
+
+//export goSourceFinalize
+func goSourceFinalize(source *C.GSource, id C.guint) {
+	funcs := callback.Get(uintptr(id)).(*SourceFuncs)
+	callback.Delete(uintptr(id))
+	if funcs.Finalize != nil {
+		funcs.Finalize((*Source)(source))
+	}
+}
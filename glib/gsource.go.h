@@ -0,0 +1,52 @@
+// Same copyright and license as the rest of the files in this project
+
+#ifndef __GSOURCE_GO_H__
+#define __GSOURCE_GO_H__
+
+#include <glib.h>
+
+// This header must be included from a single Go file, as it defines the
+// vtable of the sources implemented in Go.
+
+/*
+ * GSource implemented in Go
+ */
+
+typedef struct {
+  GSource source;
+  guint id;
+} GotkSource;
+
+extern gboolean goSourcePrepare(GSource *source, guint id, gint *timeout);
+extern gboolean goSourceCheck(GSource *source, guint id);
+extern gboolean goSourceDispatch(GSource *source, guint id);
+extern void goSourceFinalize(GSource *source, guint id);
+
+static gboolean _gotk_source_prepare(GSource *source, gint *timeout) {
+  return goSourcePrepare(source, ((GotkSource *)source)->id, timeout);
+}
+
+static gboolean _gotk_source_check(GSource *source) {
+  return goSourceCheck(source, ((GotkSource *)source)->id);
+}
+
+static gboolean _gotk_source_dispatch(GSource *source, GSourceFunc callback,
+                                      gpointer user_data) {
+  return goSourceDispatch(source, ((GotkSource *)source)->id);
+}
+
+static void _gotk_source_finalize(GSource *source) {
+  goSourceFinalize(source, ((GotkSource *)source)->id);
+}
+
+static GSourceFuncs _gotk_source_funcs = {
+    _gotk_source_prepare, _gotk_source_check, _gotk_source_dispatch,
+    _gotk_source_finalize};
+
+static inline GSource *_gotk_source_new(guint id) {
+  GSource *source = g_source_new(&_gotk_source_funcs, sizeof(GotkSource));
+  ((GotkSource *)source)->id = id;
+  return source;
+}
+
+#endif
@@ -0,0 +1,57 @@
+package glib_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func TestSourceNew(t *testing.T) {
+	ctx := glib.MainContextDefault()
+	mainLoop := glib.MainLoopNew(ctx, false)
+
+	var pending int32
+	ready := func(*glib.Source) bool { return atomic.LoadInt32(&pending) > 0 }
+	var dispatched int
+	var finalized bool
+
+	source := glib.SourceNew(&glib.SourceFuncs{
+		Prepare: func(s *glib.Source) (bool, int) { return ready(s), -1 },
+		Check:   ready,
+		Dispatch: func(*glib.Source) bool {
+			atomic.AddInt32(&pending, -1)
+			if dispatched++; dispatched == 3 {
+				mainLoop.Quit()
+				return false
+			}
+			return true
+		},
+		Finalize: func(*glib.Source) { finalized = true },
+	})
+	source.SetPriority(glib.PRIORITY_HIGH)
+	if source.GetPriority() != glib.PRIORITY_HIGH {
+		t.Error("Expected PRIORITY_HIGH, got", source.GetPriority())
+	}
+	if source.Attach(ctx) == 0 || source.GetContext() != ctx {
+		t.Error("Expected the source to be attached to the default context")
+	}
+	source.Unref()
+
+	go func() {
+		for i := 0; i < 3; i++ {
+			atomic.AddInt32(&pending, 1)
+			ctx.Wakeup()
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+	runMainLoop(t, mainLoop)
+
+	if dispatched != 3 {
+		t.Errorf("Expected 3 dispatches, got %d", dispatched)
+	}
+	if !finalized {
+		t.Error("Expected the source to be finalized")
+	}
+}
@@ -0,0 +1,102 @@
+// +build !windows
+
+package glib
+
+// #include <glib.h>
+// #include "glib.go.h"
+// #include "gsource_unix.go.h"
+import "C"
+import (
+	"github.com/gotk3/gotk3/internal/callback"
+	"github.com/gotk3/gotk3/internal/closure"
+)
+
+/*
+ * File descriptor watches
+ */
+
+// UnixFDSourceFunc is the callback of a file descriptor watch, called with
+// the conditions that are met. It returns false to remove the watch.
+type UnixFDSourceFunc func(fd int, condition IOCondition) bool
+
+// UnixFDAdd is a wrapper around g_unix_fd_add_full() watching fd in the
+// default main context with the default priority. f is called when one of
+// the conditions is met, for example IO_IN when fd is readable. fd is not
+// closed when the watch is removed.
+func UnixFDAdd(fd int, condition IOCondition, f UnixFDSourceFunc) SourceHandle {
+	id := C.gpointer(callback.Assign(f))
+	return SourceHandle(C._g_unix_fd_add(C.gint(fd), C.GIOCondition(condition), id))
+}
+
+// UnixFDSourceNew is a wrapper around g_unix_fd_source_new() calling f as
+// UnixFDAdd does. The source must be attached to a main context with
+// Attach, and the reference returned released with Unref.
+func UnixFDSourceNew(fd int, condition IOCondition, f UnixFDSourceFunc) *Source {
+	id := C.gpointer(callback.Assign(f))
+	return (*Source)(C._g_unix_fd_source_new(C.gint(fd), C.GIOCondition(condition), id))
+}
+
+//export goUnixFDSourceFunc
+func goUnixFDSourceFunc(fd C.gint, condition C.GIOCondition, data C.gpointer) C.gboolean {
+	f := callback.Get(uintptr(data)).(UnixFDSourceFunc)
+	return gbool(f(int(fd), IOCondition(condition)))
+}
+
+/*
+ * Unix signal watches
+ */
+
+// UnixSignalAdd is a wrapper around g_unix_signal_add_full() calling f in
+// the default main context with the default priority when signum is
+// received, which is one of SIGHUP, SIGINT, SIGTERM, SIGUSR1, SIGUSR2 or
+// SIGWINCH. As with IdleAdd, f is a function with no parameter, and the
+// watch is removed unless it returns true.
+//
+// The watch replaces the handling of signum by the Go runtime, so it must
+// not be used together with os/signal for the same signal.
+func UnixSignalAdd(signum int, f interface{}) SourceHandle {
+	fs := closure.NewIdleFuncStack(f, 1)
+	id := C.gpointer(callback.Assign(fs))
+	return SourceHandle(C._g_unix_signal_add(C.gint(signum), id))
+}
+
+// UnixSignalSourceNew is a wrapper around g_unix_signal_source_new()
+// calling f as UnixSignalAdd does. The source must be attached to a main
+// context with Attach, and the reference returned released with Unref.
+func UnixSignalSourceNew(signum int, f interface{}) *Source {
+	fs := closure.NewIdleFuncStack(f, 1)
+	id := C.gpointer(callback.Assign(fs))
+	return (*Source)(C._g_unix_signal_source_new(C.gint(signum), id))
+}
+
+/*
+ * Child watches
+ */
+
+// ChildWatchFunc is the callback of a child watch, called with the wait
+// status of the process once it has exited, which syscall.WaitStatus can
+// decode.
+type ChildWatchFunc func(pid int, waitStatus int)
+
+// ChildWatchAdd is a wrapper around g_child_watch_add_full() calling f in
+// the default main context with the default priority when the child process
+// pid exits. The process is reaped by GLib, so it must not be waited for
+// otherwise, for example with os.Process.Wait.
+func ChildWatchAdd(pid int, f ChildWatchFunc) SourceHandle {
+	id := C.gpointer(callback.Assign(f))
+	return SourceHandle(C._g_child_watch_add(C.GPid(pid), id))
+}
+
+// ChildWatchSourceNew is a wrapper around g_child_watch_source_new()
+// calling f as ChildWatchAdd does. The source must be attached to a main
+// context with Attach, and the reference returned released with Unref.
+func ChildWatchSourceNew(pid int, f ChildWatchFunc) *Source {
+	id := C.gpointer(callback.Assign(f))
+	return (*Source)(C._g_child_watch_source_new(C.GPid(pid), id))
+}
+
+//export goChildWatchFunc
+func goChildWatchFunc(pid C.GPid, waitStatus C.gint, data C.gpointer) {
+	f := callback.Get(uintptr(data)).(ChildWatchFunc)
+	f(int(pid), int(waitStatus))
+}
@@ -0,0 +1,55 @@
+#pragma once
+
+#include <glib-unix.h>
+#include <glib.h>
+
+// This header must be included after glib.go.h, which declares sourceFunc
+// and removeSourceFunc.
+
+extern gboolean goUnixFDSourceFunc(gint fd, GIOCondition condition,
+                                   gpointer user_data);
+
+extern void goChildWatchFunc(GPid pid, gint wait_status, gpointer user_data);
+
+static inline guint _g_unix_fd_add(gint fd, GIOCondition condition,
+                                   gpointer user_data) {
+  return g_unix_fd_add_full(G_PRIORITY_DEFAULT, fd, condition,
+                            (GUnixFDSourceFunc)(goUnixFDSourceFunc),
+                            user_data, (GDestroyNotify)(removeSourceFunc));
+}
+
+static inline GSource *_g_unix_fd_source_new(gint fd, GIOCondition condition,
+                                             gpointer user_data) {
+  GSource *source = g_unix_fd_source_new(fd, condition);
+  g_source_set_callback(source, (GSourceFunc)(goUnixFDSourceFunc), user_data,
+                        (GDestroyNotify)(removeSourceFunc));
+  return source;
+}
+
+static inline guint _g_unix_signal_add(gint signum, gpointer user_data) {
+  return g_unix_signal_add_full(G_PRIORITY_DEFAULT, signum,
+                                (GSourceFunc)(sourceFunc), user_data,
+                                (GDestroyNotify)(removeSourceFunc));
+}
+
+static inline GSource *_g_unix_signal_source_new(gint signum,
+                                                 gpointer user_data) {
+  GSource *source = g_unix_signal_source_new(signum);
+  g_source_set_callback(source, (GSourceFunc)(sourceFunc), user_data,
+                        (GDestroyNotify)(removeSourceFunc));
+  return source;
+}
+
+static inline guint _g_child_watch_add(GPid pid, gpointer user_data) {
+  return g_child_watch_add_full(G_PRIORITY_DEFAULT, pid,
+                                (GChildWatchFunc)(goChildWatchFunc), user_data,
+                                (GDestroyNotify)(removeSourceFunc));
+}
+
+static inline GSource *_g_child_watch_source_new(GPid pid,
+                                                 gpointer user_data) {
+  GSource *source = g_child_watch_source_new(pid);
+  g_source_set_callback(source, (GSourceFunc)(goChildWatchFunc), user_data,
+                        (GDestroyNotify)(removeSourceFunc));
+  return source;
+}
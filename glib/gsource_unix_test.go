@@ -0,0 +1,93 @@
+// +build !windows
+
+package glib_test
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func TestUnixFDAdd(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+
+	mainLoop := glib.MainLoopNew(glib.MainContextDefault(), false)
+	var condition glib.IOCondition
+	glib.UnixFDAdd(int(r.Fd()), glib.IO_IN, func(fd int, c glib.IOCondition) bool {
+		condition = c
+		mainLoop.Quit()
+		return false
+	})
+	w.Write([]byte("x"))
+	runMainLoop(t, mainLoop)
+
+	if condition&glib.IO_IN == 0 {
+		t.Error("Expected IO_IN, got", condition)
+	}
+}
+
+// testSignalSourceEnv is set when the test binary is run by
+// TestUnixSignalSource to install the signal handler.
+const testSignalSourceEnv = "GOTK3_TEST_SIGNAL_SOURCE"
+
+// TestUnixSignalSource runs in a subprocess of the test binary, so that the
+// SIGUSR1 handler installed by GLib does not outlive it in the test process.
+func TestUnixSignalSource(t *testing.T) {
+	if os.Getenv(testSignalSourceEnv) == "" {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestUnixSignalSource$")
+		cmd.Env = append(os.Environ(), testSignalSourceEnv+"=1")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("Subprocess failed: %v\n%s", err, out)
+		}
+		return
+	}
+
+	ctx := glib.MainContextDefault()
+	mainLoop := glib.MainLoopNew(ctx, false)
+
+	var received bool
+	source := glib.UnixSignalSourceNew(int(syscall.SIGUSR1), func() {
+		received = true
+		mainLoop.Quit()
+	})
+	source.Attach(ctx)
+	source.Unref()
+
+	syscall.Kill(os.Getpid(), syscall.SIGUSR1)
+	runMainLoop(t, mainLoop)
+
+	if !received {
+		t.Error("Expected SIGUSR1 to be received")
+	}
+}
+
+func TestChildWatchAdd(t *testing.T) {
+	path, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh not available")
+	}
+	proc, err := os.StartProcess(path, []string{"sh", "-c", "exit 7"}, &os.ProcAttr{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mainLoop := glib.MainLoopNew(glib.MainContextDefault(), false)
+	var status syscall.WaitStatus
+	glib.ChildWatchAdd(proc.Pid, func(pid int, waitStatus int) {
+		status = syscall.WaitStatus(waitStatus)
+		mainLoop.Quit()
+	})
+	runMainLoop(t, mainLoop)
+
+	if !status.Exited() || status.ExitStatus() != 7 {
+		t.Errorf("Expected exit status 7, got %v", status)
+	}
+}
@@ -0,0 +1,454 @@
+package glib
+
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+// #include "gsubprocess.go.h"
+import "C"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/internal/callback"
+)
+
+func init() {
+
+	tm := []TypeMarshaler{
+		{Type(C.g_subprocess_get_type()), marshalSubprocess},
+		{Type(C.g_subprocess_launcher_get_type()), marshalSubprocessLauncher},
+	}
+
+	RegisterGValueMarshalers(tm)
+}
+
+// SubprocessFlags is a representation of GIO's GSubprocessFlags.
+type SubprocessFlags int
+
+const (
+	SUBPROCESS_FLAGS_NONE           SubprocessFlags = C.G_SUBPROCESS_FLAGS_NONE
+	SUBPROCESS_FLAGS_STDIN_PIPE     SubprocessFlags = C.G_SUBPROCESS_FLAGS_STDIN_PIPE
+	SUBPROCESS_FLAGS_STDIN_INHERIT  SubprocessFlags = C.G_SUBPROCESS_FLAGS_STDIN_INHERIT
+	SUBPROCESS_FLAGS_STDOUT_PIPE    SubprocessFlags = C.G_SUBPROCESS_FLAGS_STDOUT_PIPE
+	SUBPROCESS_FLAGS_STDOUT_SILENCE SubprocessFlags = C.G_SUBPROCESS_FLAGS_STDOUT_SILENCE
+	SUBPROCESS_FLAGS_STDERR_PIPE    SubprocessFlags = C.G_SUBPROCESS_FLAGS_STDERR_PIPE
+	SUBPROCESS_FLAGS_STDERR_SILENCE SubprocessFlags = C.G_SUBPROCESS_FLAGS_STDERR_SILENCE
+	SUBPROCESS_FLAGS_STDERR_MERGE   SubprocessFlags = C.G_SUBPROCESS_FLAGS_STDERR_MERGE
+	SUBPROCESS_FLAGS_INHERIT_FDS    SubprocessFlags = C.G_SUBPROCESS_FLAGS_INHERIT_FDS
+)
+
+// makeStrv returns a NULL-terminated copy of strs, to be released with
+// freeStrv.
+func makeStrv(strs []string) **C.char {
+	cstrs := C.make_strings(C.int(len(strs) + 1))
+	for i, str := range strs {
+		C.set_string(cstrs, C.int(i), C.CString(str))
+	}
+	C.set_string(cstrs, C.int(len(strs)), nil)
+	return cstrs
+}
+
+// freeStrv releases an array returned by makeStrv.
+func freeStrv(cstrs **C.char) {
+	for i := 0; ; i++ {
+		cstr := C.get_string(cstrs, C.int(i))
+		if cstr == nil {
+			break
+		}
+		C.free(unsafe.Pointer(cstr))
+	}
+	C.destroy_strings(cstrs)
+}
+
+/*
+ * GSubprocess
+ */
+
+// Subprocess is a representation of GIO's GSubprocess.
+// Child processes, whose pipes are GIO streams and whose completion can be
+// awaited in the main loop with WaitAsync.
+type Subprocess struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GSubprocess.
+func (v *Subprocess) native() *C.GSubprocess {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGSubprocess(p)
+}
+
+// Native returns a pointer to the underlying GSubprocess.
+func (v *Subprocess) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalSubprocess(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapSubprocess(obj), nil
+}
+
+func wrapSubprocess(obj *Object) *Subprocess {
+	return &Subprocess{obj}
+}
+
+// subprocess converts the result of the functions spawning a GSubprocess.
+func subprocess(c *C.GSubprocess, gerr *C.GError) (*Subprocess, error) {
+	if c == nil {
+		return nil, takeError(gerr)
+	}
+	return wrapSubprocess(AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// SubprocessNew is a wrapper around g_subprocess_newv(). argv[0] is looked
+// up in PATH if it has no directory.
+func SubprocessNew(flags SubprocessFlags, argv ...string) (*Subprocess, error) {
+	cargv := makeStrv(argv)
+	defer freeStrv(cargv)
+
+	var gerr *C.GError
+	c := C.g_subprocess_newv(cargv, C.GSubprocessFlags(flags), &gerr)
+	return subprocess(c, gerr)
+}
+
+// GetIdentifier is a wrapper around g_subprocess_get_identifier(). It
+// returns the process ID as a string, or "" once the process has exited.
+func (v *Subprocess) GetIdentifier() string {
+	c := C.g_subprocess_get_identifier(v.native())
+	if c == nil {
+		return ""
+	}
+	return goString(c)
+}
+
+// GetStdinPipe is a wrapper around g_subprocess_get_stdin_pipe(). It
+// returns nil unless the process was created with
+// SUBPROCESS_FLAGS_STDIN_PIPE.
+func (v *Subprocess) GetStdinPipe() *OutputStream {
+	c := C.g_subprocess_get_stdin_pipe(v.native())
+	if c == nil {
+		return nil
+	}
+	return wrapOutputStream(Take(unsafe.Pointer(c)))
+}
+
+// GetStdoutPipe is a wrapper around g_subprocess_get_stdout_pipe(). It
+// returns nil unless the process was created with
+// SUBPROCESS_FLAGS_STDOUT_PIPE.
+func (v *Subprocess) GetStdoutPipe() *InputStream {
+	c := C.g_subprocess_get_stdout_pipe(v.native())
+	if c == nil {
+		return nil
+	}
+	return wrapInputStream(Take(unsafe.Pointer(c)))
+}
+
+// GetStderrPipe is a wrapper around g_subprocess_get_stderr_pipe(). It
+// returns nil unless the process was created with
+// SUBPROCESS_FLAGS_STDERR_PIPE.
+func (v *Subprocess) GetStderrPipe() *InputStream {
+	c := C.g_subprocess_get_stderr_pipe(v.native())
+	if c == nil {
+		return nil
+	}
+	return wrapInputStream(Take(unsafe.Pointer(c)))
+}
+
+// subprocessResult converts the result of the functions waiting for the
+// process.
+func subprocessResult(ok C.gboolean, gerr *C.GError) error {
+	if !gobool(ok) {
+		return takeError(gerr)
+	}
+	return nil
+}
+
+// Wait is a wrapper around g_subprocess_wait(). It only fails if
+// cancellable is cancelled, the exit status is given by GetStatus and the
+// related functions.
+func (v *Subprocess) Wait(cancellable *Cancellable) error {
+	var gerr *C.GError
+	ok := C.g_subprocess_wait(v.native(), cancellable.native(), &gerr)
+	return subprocessResult(ok, gerr)
+}
+
+// WaitAsync is a wrapper around g_subprocess_wait_async().
+func (v *Subprocess) WaitAsync(cancellable *Cancellable, fn AsyncReadyCallback) {
+	C._g_subprocess_wait_async(v.native(), cancellable.native(), C.gpointer(callback.Assign(fn)))
+}
+
+// WaitFinish is a wrapper around g_subprocess_wait_finish().
+func (v *Subprocess) WaitFinish(result *AsyncResult) error {
+	var gerr *C.GError
+	ok := C.g_subprocess_wait_finish(v.native(), result.native(), &gerr)
+	return subprocessResult(ok, gerr)
+}
+
+// WaitCheck is a wrapper around g_subprocess_wait_check(). Unlike Wait, it
+// also fails if the process did not exit successfully.
+func (v *Subprocess) WaitCheck(cancellable *Cancellable) error {
+	var gerr *C.GError
+	ok := C.g_subprocess_wait_check(v.native(), cancellable.native(), &gerr)
+	return subprocessResult(ok, gerr)
+}
+
+// WaitCheckAsync is a wrapper around g_subprocess_wait_check_async().
+func (v *Subprocess) WaitCheckAsync(cancellable *Cancellable, fn AsyncReadyCallback) {
+	C._g_subprocess_wait_check_async(v.native(), cancellable.native(), C.gpointer(callback.Assign(fn)))
+}
+
+// WaitCheckFinish is a wrapper around g_subprocess_wait_check_finish().
+func (v *Subprocess) WaitCheckFinish(result *AsyncResult) error {
+	var gerr *C.GError
+	ok := C.g_subprocess_wait_check_finish(v.native(), result.native(), &gerr)
+	return subprocessResult(ok, gerr)
+}
+
+// ForceExit is a wrapper around g_subprocess_force_exit().
+func (v *Subprocess) ForceExit() {
+	C.g_subprocess_force_exit(v.native())
+}
+
+// GetSuccessful is a wrapper around g_subprocess_get_successful().
+// The process must have exited.
+func (v *Subprocess) GetSuccessful() bool {
+	return gobool(C.g_subprocess_get_successful(v.native()))
+}
+
+// GetIfExited is a wrapper around g_subprocess_get_if_exited().
+// The process must have exited.
+func (v *Subprocess) GetIfExited() bool {
+	return gobool(C.g_subprocess_get_if_exited(v.native()))
+}
+
+// GetExitStatus is a wrapper around g_subprocess_get_exit_status().
+// It is only meaningful if GetIfExited returns true.
+func (v *Subprocess) GetExitStatus() int {
+	return int(C.g_subprocess_get_exit_status(v.native()))
+}
+
+// GetIfSignaled is a wrapper around g_subprocess_get_if_signaled().
+// The process must have exited.
+func (v *Subprocess) GetIfSignaled() bool {
+	return gobool(C.g_subprocess_get_if_signaled(v.native()))
+}
+
+// GetTermSig is a wrapper around g_subprocess_get_term_sig().
+// It is only meaningful if GetIfSignaled returns true.
+func (v *Subprocess) GetTermSig() int {
+	return int(C.g_subprocess_get_term_sig(v.native()))
+}
+
+// GetStatus is a wrapper around g_subprocess_get_status().
+// It returns the raw wait status of the process, which must have exited.
+func (v *Subprocess) GetStatus() int {
+	return int(C.g_subprocess_get_status(v.native()))
+}
+
+// Communicate is a wrapper around g_subprocess_communicate(). stdin is
+// written to the standard input of the process, which is then closed, and
+// its standard output and error are read until it exits. stdout and stderr
+// are nil unless they are pipes.
+func (v *Subprocess) Communicate(stdin []byte, cancellable *Cancellable) (stdout, stderr []byte, err error) {
+	var cstdin *C.GBytes
+	if stdin != nil {
+		cstdin = newGBytes(stdin)
+		defer C.g_bytes_unref(cstdin)
+	}
+
+	var cstdout, cstderr *C.GBytes
+	var gerr *C.GError
+	ok := C.g_subprocess_communicate(v.native(), cstdin, cancellable.native(), &cstdout, &cstderr, &gerr)
+	return communicateBytes(ok, cstdout, cstderr, gerr)
+}
+
+// CommunicateAsync is a wrapper around g_subprocess_communicate_async().
+func (v *Subprocess) CommunicateAsync(stdin []byte, cancellable *Cancellable, fn AsyncReadyCallback) {
+	var cstdin *C.GBytes
+	if stdin != nil {
+		cstdin = newGBytes(stdin)
+		defer C.g_bytes_unref(cstdin)
+	}
+
+	C._g_subprocess_communicate_async(v.native(), cstdin, cancellable.native(), C.gpointer(callback.Assign(fn)))
+}
+
+// CommunicateFinish is a wrapper around g_subprocess_communicate_finish().
+// It returns the same values as Communicate.
+func (v *Subprocess) CommunicateFinish(result *AsyncResult) (stdout, stderr []byte, err error) {
+	var cstdout, cstderr *C.GBytes
+	var gerr *C.GError
+	ok := C.g_subprocess_communicate_finish(v.native(), result.native(), &cstdout, &cstderr, &gerr)
+	return communicateBytes(ok, cstdout, cstderr, gerr)
+}
+
+func communicateBytes(ok C.gboolean, cstdout, cstderr *C.GBytes, gerr *C.GError) (stdout, stderr []byte, err error) {
+	if cstdout != nil {
+		defer C.g_bytes_unref(cstdout)
+		stdout = goBytes(cstdout)
+	}
+	if cstderr != nil {
+		defer C.g_bytes_unref(cstderr)
+		stderr = goBytes(cstderr)
+	}
+	if !gobool(ok) {
+		return nil, nil, takeError(gerr)
+	}
+	return stdout, stderr, nil
+}
+
+// CommunicateUTF8 is a wrapper around g_subprocess_communicate_utf8(). It is
+// like Communicate, except that the output of the process must be valid
+// UTF-8.
+func (v *Subprocess) CommunicateUTF8(stdin string, cancellable *Cancellable) (stdout, stderr string, err error) {
+	var cstdin *C.char
+	if stdin != "" {
+		cstdin = C.CString(stdin)
+		defer C.free(unsafe.Pointer(cstdin))
+	}
+
+	var cstdout, cstderr *C.char
+	var gerr *C.GError
+	ok := C.g_subprocess_communicate_utf8(v.native(), cstdin, cancellable.native(), &cstdout, &cstderr, &gerr)
+	return communicateStrings(ok, cstdout, cstderr, gerr)
+}
+
+// CommunicateUTF8Async is a wrapper around
+// g_subprocess_communicate_utf8_async().
+func (v *Subprocess) CommunicateUTF8Async(stdin string, cancellable *Cancellable, fn AsyncReadyCallback) {
+	var cstdin *C.char
+	if stdin != "" {
+		cstdin = C.CString(stdin)
+		defer C.free(unsafe.Pointer(cstdin))
+	}
+
+	C._g_subprocess_communicate_utf8_async(v.native(), cstdin, cancellable.native(), C.gpointer(callback.Assign(fn)))
+}
+
+// CommunicateUTF8Finish is a wrapper around
+// g_subprocess_communicate_utf8_finish(). It returns the same values as
+// CommunicateUTF8.
+func (v *Subprocess) CommunicateUTF8Finish(result *AsyncResult) (stdout, stderr string, err error) {
+	var cstdout, cstderr *C.char
+	var gerr *C.GError
+	ok := C.g_subprocess_communicate_utf8_finish(v.native(), result.native(), &cstdout, &cstderr, &gerr)
+	return communicateStrings(ok, cstdout, cstderr, gerr)
+}
+
+func communicateStrings(ok C.gboolean, cstdout, cstderr *C.char, gerr *C.GError) (stdout, stderr string, err error) {
+	defer C.g_free(C.gpointer(cstdout))
+	defer C.g_free(C.gpointer(cstderr))
+	if !gobool(ok) {
+		return "", "", takeError(gerr)
+	}
+	return C.GoString(cstdout), C.GoString(cstderr), nil
+}
+
+/*
+ * GSubprocessLauncher
+ */
+
+// SubprocessLauncher is a representation of GIO's GSubprocessLauncher.
+// Environment options for launching a child process
+type SubprocessLauncher struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GSubprocessLauncher.
+func (v *SubprocessLauncher) native() *C.GSubprocessLauncher {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGSubprocessLauncher(p)
+}
+
+// Native returns a pointer to the underlying GSubprocessLauncher.
+func (v *SubprocessLauncher) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalSubprocessLauncher(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapSubprocessLauncher(obj), nil
+}
+
+func wrapSubprocessLauncher(obj *Object) *SubprocessLauncher {
+	return &SubprocessLauncher{obj}
+}
+
+// SubprocessLauncherNew is a wrapper around g_subprocess_launcher_new().
+// The environment of the launcher is initially a copy of the environment
+// of the current process.
+func SubprocessLauncherNew(flags SubprocessFlags) *SubprocessLauncher {
+	c := C.g_subprocess_launcher_new(C.GSubprocessFlags(flags))
+	return wrapSubprocessLauncher(AssumeOwnership(unsafe.Pointer(c)))
+}
+
+// Spawn is a wrapper around g_subprocess_launcher_spawnv().
+func (v *SubprocessLauncher) Spawn(argv ...string) (*Subprocess, error) {
+	cargv := makeStrv(argv)
+	defer freeStrv(cargv)
+
+	var gerr *C.GError
+	c := C.g_subprocess_launcher_spawnv(v.native(), cargv, &gerr)
+	return subprocess(c, gerr)
+}
+
+// SetFlags is a wrapper around g_subprocess_launcher_set_flags().
+func (v *SubprocessLauncher) SetFlags(flags SubprocessFlags) {
+	C.g_subprocess_launcher_set_flags(v.native(), C.GSubprocessFlags(flags))
+}
+
+// SetEnviron is a wrapper around g_subprocess_launcher_set_environ().
+// env is a list of "NAME=value" strings, like os.Environ returns, and
+// replaces the whole environment.
+func (v *SubprocessLauncher) SetEnviron(env []string) {
+	cenv := makeStrv(env)
+	defer freeStrv(cenv)
+
+	C.g_subprocess_launcher_set_environ(v.native(), cenv)
+}
+
+// Setenv is a wrapper around g_subprocess_launcher_setenv().
+func (v *SubprocessLauncher) Setenv(variable, value string, overwrite bool) {
+	cvariable := (*C.gchar)(C.CString(variable))
+	defer C.free(unsafe.Pointer(cvariable))
+	cvalue := (*C.gchar)(C.CString(value))
+	defer C.free(unsafe.Pointer(cvalue))
+
+	C.g_subprocess_launcher_setenv(v.native(), cvariable, cvalue, gbool(overwrite))
+}
+
+// Unsetenv is a wrapper around g_subprocess_launcher_unsetenv().
+func (v *SubprocessLauncher) Unsetenv(variable string) {
+	cvariable := (*C.gchar)(C.CString(variable))
+	defer C.free(unsafe.Pointer(cvariable))
+
+	C.g_subprocess_launcher_unsetenv(v.native(), cvariable)
+}
+
+// Getenv is a wrapper around g_subprocess_launcher_getenv(). ok is false
+// if variable is not set.
+func (v *SubprocessLauncher) Getenv(variable string) (value string, ok bool) {
+	cvariable := (*C.gchar)(C.CString(variable))
+	defer C.free(unsafe.Pointer(cvariable))
+
+	c := C.g_subprocess_launcher_getenv(v.native(), cvariable)
+	if c == nil {
+		return "", false
+	}
+	return goString(c), true
+}
+
+// SetCwd is a wrapper around g_subprocess_launcher_set_cwd().
+func (v *SubprocessLauncher) SetCwd(cwd string) {
+	cstr := (*C.gchar)(C.CString(cwd))
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_subprocess_launcher_set_cwd(v.native(), cstr)
+}
@@ -0,0 +1,51 @@
+#pragma once
+
+#include <gio/gio.h>
+#include <glib.h>
+#include <stdlib.h>
+
+static GSubprocess *toGSubprocess(void *p) { return (G_SUBPROCESS(p)); }
+
+static GSubprocessLauncher *toGSubprocessLauncher(void *p) {
+  return (G_SUBPROCESS_LAUNCHER(p));
+}
+
+/*
+ * GAsyncReadyCallback
+ */
+
+extern void goAsyncReadyCallbacks(GObject *source_object, GAsyncResult *res,
+                                  gpointer user_data);
+
+static inline void _g_subprocess_wait_async(GSubprocess *subprocess,
+                                            GCancellable *cancellable,
+                                            gpointer user_data) {
+  g_subprocess_wait_async(subprocess, cancellable,
+                          (GAsyncReadyCallback)(goAsyncReadyCallbacks),
+                          user_data);
+}
+
+static inline void _g_subprocess_wait_check_async(GSubprocess *subprocess,
+                                                  GCancellable *cancellable,
+                                                  gpointer user_data) {
+  g_subprocess_wait_check_async(subprocess, cancellable,
+                                (GAsyncReadyCallback)(goAsyncReadyCallbacks),
+                                user_data);
+}
+
+static inline void _g_subprocess_communicate_async(GSubprocess *subprocess,
+                                                   GBytes *stdin_buf,
+                                                   GCancellable *cancellable,
+                                                   gpointer user_data) {
+  g_subprocess_communicate_async(subprocess, stdin_buf, cancellable,
+                                 (GAsyncReadyCallback)(goAsyncReadyCallbacks),
+                                 user_data);
+}
+
+static inline void _g_subprocess_communicate_utf8_async(
+    GSubprocess *subprocess, const char *stdin_buf, GCancellable *cancellable,
+    gpointer user_data) {
+  g_subprocess_communicate_utf8_async(
+      subprocess, stdin_buf, cancellable,
+      (GAsyncReadyCallback)(goAsyncReadyCallbacks), user_data);
+}
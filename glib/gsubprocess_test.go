@@ -0,0 +1,104 @@
+package glib_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func requireShell(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not available")
+	}
+}
+
+func TestSubprocessLauncher(t *testing.T) {
+	requireShell(t)
+
+	tmp, err := ioutil.TempDir("", "gotk3-subprocess")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	tmp, _ = filepath.EvalSymlinks(tmp)
+
+	launcher := glib.SubprocessLauncherNew(glib.SUBPROCESS_FLAGS_STDIN_PIPE |
+		glib.SUBPROCESS_FLAGS_STDOUT_PIPE | glib.SUBPROCESS_FLAGS_STDERR_PIPE)
+	launcher.Setenv("GOTK3_TEST", "value", true)
+	launcher.SetCwd(tmp)
+	if value, ok := launcher.Getenv("GOTK3_TEST"); !ok || value != "value" {
+		t.Errorf("Getenv returned %q, %v", value, ok)
+	}
+
+	proc, err := launcher.Spawn("sh", "-c", "cat; echo $GOTK3_TEST; pwd -P; echo err >&2")
+	if err != nil {
+		t.Fatal("Spawn failed:", err)
+	}
+
+	mainLoop := glib.MainLoopNew(glib.MainContextDefault(), false)
+	var stdout, stderr string
+	proc.CommunicateUTF8Async("input\n", nil, func(_ *glib.Object, res *glib.AsyncResult) {
+		stdout, stderr, err = proc.CommunicateUTF8Finish(res)
+		mainLoop.Quit()
+	})
+	runMainLoop(t, mainLoop)
+
+	if err != nil {
+		t.Fatal("Communicate failed:", err)
+	}
+	if expected := "input\nvalue\n" + tmp + "\n"; stdout != expected {
+		t.Errorf("Expected stdout %q, got %q", expected, stdout)
+	}
+	if stderr != "err\n" {
+		t.Errorf("Expected stderr %q, got %q", "err\n", stderr)
+	}
+	if err := proc.Wait(nil); err != nil || !proc.GetSuccessful() {
+		t.Error("Expected the process to be successful", err)
+	}
+}
+
+func TestSubprocessWait(t *testing.T) {
+	requireShell(t)
+
+	proc, err := glib.SubprocessNew(glib.SUBPROCESS_FLAGS_NONE, "sh", "-c", "exit 3")
+	if err != nil {
+		t.Fatal("SubprocessNew failed:", err)
+	}
+
+	mainLoop := glib.MainLoopNew(glib.MainContextDefault(), false)
+	proc.WaitCheckAsync(nil, func(_ *glib.Object, res *glib.AsyncResult) {
+		err = proc.WaitCheckFinish(res)
+		mainLoop.Quit()
+	})
+	runMainLoop(t, mainLoop)
+
+	if err == nil {
+		t.Error("Expected WaitCheck to fail")
+	}
+	if !proc.GetIfExited() || proc.GetExitStatus() != 3 {
+		t.Errorf("Expected exit status 3, got %d", proc.GetExitStatus())
+	}
+
+	proc, err = glib.SubprocessNew(glib.SUBPROCESS_FLAGS_NONE, "sh", "-c", "sleep 10")
+	if err != nil {
+		t.Fatal("SubprocessNew failed:", err)
+	}
+	if proc.GetIdentifier() == "" {
+		t.Error("Expected the identifier of a running process")
+	}
+	proc.ForceExit()
+	if err := proc.Wait(nil); err != nil {
+		t.Fatal("Wait failed:", err)
+	}
+	if !proc.GetIfSignaled() || proc.GetSuccessful() {
+		t.Error("Expected the process to be killed")
+	}
+
+	if _, err := glib.SubprocessNew(glib.SUBPROCESS_FLAGS_NONE, "gotk3-no-such-command"); err == nil {
+		t.Error("Expected an error for a missing command")
+	}
+}
@@ -0,0 +1,69 @@
+// +build !windows
+
+package glib
+
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "gsubprocess.go.h"
+import "C"
+import (
+	"unsafe"
+)
+
+// SendSignal is a wrapper around g_subprocess_send_signal().
+func (v *Subprocess) SendSignal(signalNum int) {
+	C.g_subprocess_send_signal(v.native(), C.gint(signalNum))
+}
+
+// SetStdinFilePath is a wrapper around
+// g_subprocess_launcher_set_stdin_file_path().
+func (v *SubprocessLauncher) SetStdinFilePath(path string) {
+	cstr := (*C.gchar)(C.CString(path))
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_subprocess_launcher_set_stdin_file_path(v.native(), cstr)
+}
+
+// SetStdoutFilePath is a wrapper around
+// g_subprocess_launcher_set_stdout_file_path().
+func (v *SubprocessLauncher) SetStdoutFilePath(path string) {
+	cstr := (*C.gchar)(C.CString(path))
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_subprocess_launcher_set_stdout_file_path(v.native(), cstr)
+}
+
+// SetStderrFilePath is a wrapper around
+// g_subprocess_launcher_set_stderr_file_path().
+func (v *SubprocessLauncher) SetStderrFilePath(path string) {
+	cstr := (*C.gchar)(C.CString(path))
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_subprocess_launcher_set_stderr_file_path(v.native(), cstr)
+}
+
+// TakeStdinFd is a wrapper around g_subprocess_launcher_take_stdin_fd().
+// The launcher closes fd once it is freed.
+func (v *SubprocessLauncher) TakeStdinFd(fd int) {
+	C.g_subprocess_launcher_take_stdin_fd(v.native(), C.gint(fd))
+}
+
+// TakeStdoutFd is a wrapper around g_subprocess_launcher_take_stdout_fd().
+// The launcher closes fd once it is freed.
+func (v *SubprocessLauncher) TakeStdoutFd(fd int) {
+	C.g_subprocess_launcher_take_stdout_fd(v.native(), C.gint(fd))
+}
+
+// TakeStderrFd is a wrapper around g_subprocess_launcher_take_stderr_fd().
+// The launcher closes fd once it is freed.
+func (v *SubprocessLauncher) TakeStderrFd(fd int) {
+	C.g_subprocess_launcher_take_stderr_fd(v.native(), C.gint(fd))
+}
+
+// TakeFd is a wrapper around g_subprocess_launcher_take_fd(). sourceFd is
+// made available as targetFd in the process, and closed by the launcher
+// once it is freed.
+func (v *SubprocessLauncher) TakeFd(sourceFd, targetFd int) {
+	C.g_subprocess_launcher_take_fd(v.native(), C.gint(sourceFd), C.gint(targetFd))
+}
@@ -0,0 +1,372 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+// #include "gtype.go.h"
+import "C"
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"sync"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/internal/closure"
+)
+
+/*
+ * GObjectClass
+ */
+
+// ObjectClass is a representation of GLib's GObjectClass.
+type ObjectClass struct {
+	GObjectClass *C.GObjectClass
+}
+
+// native returns a pointer to the underlying GObjectClass.
+func (v *ObjectClass) native() *C.GObjectClass {
+	if v == nil || v.GObjectClass == nil {
+		return nil
+	}
+	return v.GObjectClass
+}
+
+// Native returns a pointer to the underlying GObjectClass.
+func (v *ObjectClass) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func wrapObjectClass(p C.gpointer) *ObjectClass {
+	return &ObjectClass{C.toGObjectClass(unsafe.Pointer(p))}
+}
+
+// Type is a wrapper around the G_TYPE_FROM_CLASS() macro.
+func (v *ObjectClass) Type() Type {
+	return Type(C._g_type_from_class(C.gpointer(v.native())))
+}
+
+/*
+ * Go-registered types
+ */
+
+// ClassInitFunc is called once, when the class of a type registered with
+// RegisterType is first initialized.
+type ClassInitFunc func(class *ObjectClass)
+
+// TypeInfo describes a GObject subclass implemented in Go. It is passed to
+// RegisterType.
+type TypeInfo struct {
+	// ClassInit is optional. It is called before the first instance of the
+	// type is created, and is the place to override class members.
+	ClassInit ClassInitFunc
+
+	// InstanceInit must be a function of the form func(*glib.Object) *T,
+	// like the wrapper functions in WrapMap. It is called for every new
+	// instance of the type and returns the Go value which stands for that
+	// instance from then on: it is what GoValue, GetProperty, signal handlers
+	// and gtk.Builder's GetObject return for it.
+	//
+	// The given Object does not hold a reference to the instance, as the
+	// instance owns the Go value and not the other way around. The Go value
+	// is released when the instance is finalized. The instance is not fully
+	// constructed yet when InstanceInit runs, so it should do nothing more
+	// than set up the Go value.
+	//
+	// When a type registered from Go is itself subclassed from Go, only the
+	// InstanceInit of the most derived type is called.
+	InstanceInit WrapFn
+}
+
+// goType is the internal bookkeeping for a type registered with RegisterType.
+type goType struct {
+	info *TypeInfo
+	init closure.FuncStack
+}
+
+var (
+	goTypesMu sync.RWMutex
+	goTypes   = map[Type]*goType{}
+
+	goInstances  = sync.Map{} // unsafe.Pointer(*GObject) -> interface{}
+	goProperties = sync.Map{} // unsafe.Pointer(*GObject) -> *propertyStore
+)
+
+// RegisterType is a wrapper around g_type_register_static(). It registers a
+// new type with the given name deriving from parent, which must be a
+// GObject-derived class type. The class and instance structures of the new
+// type are those of the parent, all state is kept on the Go side.
+//
+// Once registered, the type is known to the type system by name, so it can be
+// used in GtkBuilder definitions, and the Go value created by
+// info.InstanceInit is returned for its instances wherever a GValue is
+// converted to Go.
+func RegisterType(name string, parent Type, info *TypeInfo) (Type, error) {
+	if info == nil || info.InstanceInit == nil {
+		return TYPE_INVALID, errors.New("missing InstanceInit function")
+	}
+	if !parent.IsA(TYPE_OBJECT) {
+		return TYPE_INVALID, fmt.Errorf("parent type %s is not a GObject", parent.Name())
+	}
+
+	if reflect.TypeOf(info.InstanceInit).Kind() != reflect.Func {
+		return TYPE_INVALID, errors.New("InstanceInit is not a function")
+	}
+
+	fs := closure.NewFuncStack(info.InstanceInit, 1)
+	initType := fs.Func.Type()
+	if initType.NumIn() != 1 || initType.In(0) != reflect.TypeOf((*Object)(nil)) {
+		return TYPE_INVALID, errors.New("InstanceInit must take a single *glib.Object")
+	}
+	if initType.NumOut() != 1 || initType.Out(0).Kind() != reflect.Ptr {
+		return TYPE_INVALID, errors.New("InstanceInit must return a single pointer")
+	}
+
+	cstr := C.CString(name)
+	defer C.free(unsafe.Pointer(cstr))
+
+	t := Type(C._g_type_register_static_go(C.GType(parent), (*C.gchar)(cstr)))
+	if t == TYPE_INVALID {
+		return TYPE_INVALID, fmt.Errorf("unable to register type %s", name)
+	}
+
+	goTypesMu.Lock()
+	goTypes[t] = &goType{info: info, init: fs}
+	goTypesMu.Unlock()
+
+	RegisterGValueMarshalers([]TypeMarshaler{{t, marshalGoInstance}})
+
+	// The wrapper ignores the Object it is given and returns the value
+	// associated with the instance, so that Go state survives repeated
+	// lookups.
+	WrapMap[name] = reflect.MakeFunc(initType, func(args []reflect.Value) []reflect.Value {
+		obj := args[0].Interface().(*Object)
+		v, err := goInstance(unsafe.Pointer(obj.native()))
+		if err != nil {
+			return []reflect.Value{reflect.Zero(initType.Out(0))}
+		}
+		return []reflect.Value{reflect.ValueOf(v)}
+	}).Interface()
+
+	return t, nil
+}
+
+// lookupGoType returns the closest type registered from Go that t is or
+// derives from, or nil if there is none.
+func lookupGoType(t Type) (Type, *goType) {
+	goTypesMu.RLock()
+	defer goTypesMu.RUnlock()
+
+	for ; t != TYPE_INVALID; t = t.Parent() {
+		if gt, ok := goTypes[t]; ok {
+			return t, gt
+		}
+	}
+	return TYPE_INVALID, nil
+}
+
+// goInstance returns the Go value associated with the given GObject pointer.
+func goInstance(p unsafe.Pointer) (interface{}, error) {
+	v, ok := goInstances.Load(p)
+	if !ok {
+		return nil, errors.New("object has no Go instance")
+	}
+	return v, nil
+}
+
+func marshalGoInstance(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	return goInstance(unsafe.Pointer(c))
+}
+
+//export goClassInit
+func goClassInit(gClass C.gpointer, classData C.gpointer) {
+	class := wrapObjectClass(gClass)
+
+	goTypesMu.RLock()
+	gt := goTypes[class.Type()]
+	goTypesMu.RUnlock()
+
+	if gt != nil && gt.info.ClassInit != nil {
+		gt.info.ClassInit(class)
+	}
+}
+
+//export goInstanceInit
+func goInstanceInit(instance *C.GTypeInstance, gClass C.gpointer) {
+	p := unsafe.Pointer(instance)
+	if _, ok := goInstances.Load(p); ok {
+		// Already set up by a more derived type.
+		return
+	}
+
+	_, gt := lookupGoType(Type(C._g_type_from_class(gClass)))
+	if gt == nil {
+		return
+	}
+
+	defer gt.init.TryRepanic()
+
+	obj := newObject(C.toGObject(p))
+	rv := gt.init.Func.Call([]reflect.Value{reflect.ValueOf(obj)})
+
+	goInstances.Store(p, rv[0].Interface())
+	C._go_instance_attach(obj.native())
+}
+
+//export goInstanceFinalize
+func goInstanceFinalize(data C.gpointer) {
+	goInstances.Delete(unsafe.Pointer(data))
+	goProperties.Delete(unsafe.Pointer(data))
+}
+
+/*
+ * Properties
+ */
+
+// PropertyHandler may be implemented by the Go value returned from a
+// TypeInfo's InstanceInit to take over storage of the properties installed
+// with ObjectClass.InstallProperty. The given Value is already initialized to
+// the type of the property.
+//
+// Go values which don't implement PropertyHandler get their properties stored
+// by gotk3, starting out with the default value of each GParamSpec.
+type PropertyHandler interface {
+	SetObjectProperty(id uint, value *Value, pspec *ParamSpec)
+	GetObjectProperty(id uint, value *Value, pspec *ParamSpec)
+}
+
+// propertyStore holds the property values of an instance whose Go value does
+// not implement PropertyHandler.
+type propertyStore struct {
+	mu     sync.Mutex
+	values map[uint]*Value
+}
+
+// InstallProperty is a wrapper around g_object_class_install_property(). It
+// must be called from a TypeInfo's ClassInit. id must be greater than 0 and
+// unique within the class.
+func (v *ObjectClass) InstallProperty(id uint, pspec *ParamSpec) {
+	C._g_object_class_install_property(v.native(), C.guint(id), pspec.native())
+}
+
+// FindProperty is a wrapper around g_object_class_find_property(). It returns
+// nil if the class has no property of that name.
+func (v *ObjectClass) FindProperty(name string) *ParamSpec {
+	cstr := C.CString(name)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return newParamSpec(C.g_object_class_find_property(v.native(), (*C.gchar)(cstr)))
+}
+
+// ListProperties is a wrapper around g_object_class_list_properties().
+func (v *ObjectClass) ListProperties() []*ParamSpec {
+	var n C.guint
+	c := C.g_object_class_list_properties(v.native(), &n)
+	defer C.g_free(C.gpointer(c))
+
+	pspecs := make([]*ParamSpec, 0, int(n))
+	for i := C.guint(0); i < n; i++ {
+		pspecs = append(pspecs, newParamSpec(C.get_pspec(c, i)))
+	}
+	return pspecs
+}
+
+// GetClass is a wrapper around the G_OBJECT_GET_CLASS() macro.
+func (v *Object) GetClass() *ObjectClass {
+	return &ObjectClass{C._g_object_get_class(v.native())}
+}
+
+// Notify is a wrapper around g_object_notify(). It emits "notify::name", and
+// is meant to be called by Go types when they change one of their properties
+// without going through SetProperty.
+func (v *Object) Notify(name string) {
+	cstr := C.CString(name)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_object_notify(v.native(), (*C.gchar)(cstr))
+}
+
+// NotifyByPspec is a wrapper around g_object_notify_by_pspec().
+func (v *Object) NotifyByPspec(pspec *ParamSpec) {
+	C.g_object_notify_by_pspec(v.native(), pspec.native())
+}
+
+//export goObjectSetProperty
+func goObjectSetProperty(object *C.GObject, id C.guint, value *C.GValue, pspec *C.GParamSpec) {
+	p := unsafe.Pointer(object)
+
+	if inst, ok := goInstances.Load(p); ok {
+		if h, ok := inst.(PropertyHandler); ok {
+			h.SetObjectProperty(uint(id), &Value{value}, newParamSpec(pspec))
+			return
+		}
+	}
+
+	val, err := ValueInit(Type(pspec.value_type))
+	if err != nil {
+		return
+	}
+	C.g_value_copy(value, val.native())
+
+	s, _ := goProperties.LoadOrStore(p, &propertyStore{values: map[uint]*Value{}})
+	store := s.(*propertyStore)
+
+	store.mu.Lock()
+	store.values[uint(id)] = val
+	store.mu.Unlock()
+}
+
+//export goObjectGetProperty
+func goObjectGetProperty(object *C.GObject, id C.guint, value *C.GValue, pspec *C.GParamSpec) {
+	p := unsafe.Pointer(object)
+
+	if inst, ok := goInstances.Load(p); ok {
+		if h, ok := inst.(PropertyHandler); ok {
+			h.GetObjectProperty(uint(id), &Value{value}, newParamSpec(pspec))
+			return
+		}
+	}
+
+	if s, ok := goProperties.Load(p); ok {
+		store := s.(*propertyStore)
+
+		store.mu.Lock()
+		val, ok := store.values[uint(id)]
+		store.mu.Unlock()
+
+		if ok {
+			C.g_value_copy(val.native(), value)
+			return
+		}
+	}
+
+	C.g_param_value_set_default(pspec, value)
+}
+
+// ObjectNew is a wrapper around g_object_new(). It creates an instance of the
+// given type, without setting any properties, and takes ownership of it.
+func ObjectNew(t Type) (*Object, error) {
+	c := C._g_object_new(C.GType(t))
+	if c == nil {
+		return nil, nilPtrErr
+	}
+
+	// Widgets and other GInitiallyUnowned types start with a floating
+	// reference, which is claimed here.
+	obj := newObject(c)
+	if obj.IsFloating() {
+		obj.RefSink()
+	}
+	runtime.SetFinalizer(obj, func(v *Object) { FinalizerStrategy(v.Unref) })
+	return obj, nil
+}
+
+// GoValue converts the Object to the Go type registered for its actual type,
+// e.g. a *gtk.Entry or a value created by a TypeInfo's InstanceInit.
+func (v *Object) GoValue() (interface{}, error) {
+	return v.goValue()
+}
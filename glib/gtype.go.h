@@ -0,0 +1,79 @@
+// Same copyright and license as the rest of the files in this project
+
+#ifndef __GTYPE_GO_H__
+#define __GTYPE_GO_H__
+
+#include <glib-object.h>
+#include <glib.h>
+#include <stdlib.h>
+
+extern void goClassInit(gpointer g_class, gpointer class_data);
+extern void goInstanceInit(GTypeInstance *instance, gpointer g_class);
+extern void goInstanceFinalize(gpointer data);
+extern void goObjectSetProperty(GObject *object, guint property_id,
+                                GValue *value, GParamSpec *pspec);
+extern void goObjectGetProperty(GObject *object, guint property_id,
+                                GValue *value, GParamSpec *pspec);
+
+static GType _g_type_from_class(gpointer g_class) {
+  return (G_TYPE_FROM_CLASS(g_class));
+}
+
+static GObjectClass *toGObjectClass(void *p) { return (G_OBJECT_CLASS(p)); }
+
+static GQuark _go_instance_quark() {
+  return g_quark_from_static_string("gotk3-go-instance");
+}
+
+/*
+ * Registers a static type deriving from parent, whose class and instance
+ * structures have the same size as the parent's. All class and instance
+ * initialization is forwarded to Go.
+ */
+static GType _g_type_register_static_go(GType parent, const gchar *name) {
+  GTypeQuery query;
+  GTypeInfo info = {0};
+
+  g_type_query(parent, &query);
+  if (query.type == G_TYPE_INVALID) {
+    return G_TYPE_INVALID;
+  }
+
+  info.class_size = (guint16)query.class_size;
+  info.class_init = (GClassInitFunc)goClassInit;
+  info.instance_size = (guint16)query.instance_size;
+  info.instance_init = (GInstanceInitFunc)goInstanceInit;
+
+  return g_type_register_static(parent, name, &info, 0);
+}
+
+/*
+ * The Go value associated with an instance is released when the instance's
+ * qdata is cleared during finalization.
+ */
+static void _go_instance_attach(GObject *object) {
+  g_object_set_qdata_full(object, _go_instance_quark(), object,
+                          (GDestroyNotify)goInstanceFinalize);
+}
+
+/*
+ * Properties installed from Go are stored and retrieved by Go.
+ */
+static void _g_object_class_install_property(GObjectClass *klass,
+                                             guint property_id,
+                                             GParamSpec *pspec) {
+  klass->set_property = (void (*)(GObject *, guint, const GValue *,
+                                  GParamSpec *))goObjectSetProperty;
+  klass->get_property = goObjectGetProperty;
+  g_object_class_install_property(klass, property_id, pspec);
+}
+
+static inline GParamSpec *get_pspec(GParamSpec **pspecs, guint n) {
+  return pspecs[n];
+}
+
+static GObject *_g_object_new(GType type) {
+  return (G_OBJECT(g_object_new(type, NULL)));
+}
+
+#endif
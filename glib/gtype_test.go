@@ -0,0 +1,136 @@
+package glib_test
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+type testThing struct {
+	*glib.Object
+	counter int
+}
+
+func TestRegisterType(t *testing.T) {
+	var classInits int
+
+	typ, err := glib.RegisterType("GotkTestThing", glib.TYPE_OBJECT, &glib.TypeInfo{
+		ClassInit: func(class *glib.ObjectClass) {
+			classInits++
+		},
+		InstanceInit: func(obj *glib.Object) *testThing {
+			return &testThing{Object: obj}
+		},
+	})
+	if err != nil {
+		t.Fatal("RegisterType failed:", err)
+	}
+
+	if name := typ.Name(); name != "GotkTestThing" {
+		t.Errorf("Expected GotkTestThing, got %s", name)
+	}
+	if !typ.IsA(glib.TYPE_OBJECT) {
+		t.Error("Expected registered type to derive from GObject")
+	}
+
+	obj, err := glib.ObjectNew(typ)
+	if err != nil {
+		t.Fatal("ObjectNew failed:", err)
+	}
+	if obj.TypeFromInstance() != typ {
+		t.Errorf("Expected instance type %s, got %s", typ.Name(), obj.TypeFromInstance().Name())
+	}
+
+	v, err := obj.GoValue()
+	if err != nil {
+		t.Fatal("GoValue failed:", err)
+	}
+	thing, ok := v.(*testThing)
+	if !ok {
+		t.Fatalf("Expected *testThing, got %T", v)
+	}
+	thing.counter++
+
+	// The same Go value must be returned on every lookup.
+	v, _ = obj.GoValue()
+	if v.(*testThing).counter != 1 {
+		t.Error("Expected Go state to be kept between lookups")
+	}
+
+	if _, err := glib.ObjectNew(typ); err != nil {
+		t.Fatal("ObjectNew failed:", err)
+	}
+	if classInits != 1 {
+		t.Errorf("Expected ClassInit to run once, ran %d times", classInits)
+	}
+}
+
+func TestRegisterTypeInvalidInit(t *testing.T) {
+	_, err := glib.RegisterType("GotkTestInvalid", glib.TYPE_OBJECT, &glib.TypeInfo{
+		InstanceInit: func() {},
+	})
+	if err == nil {
+		t.Error("Expected error for invalid InstanceInit")
+	}
+}
+
+func TestInstallProperty(t *testing.T) {
+	typ, err := glib.RegisterType("GotkTestPropertyThing", glib.TYPE_OBJECT, &glib.TypeInfo{
+		ClassInit: func(class *glib.ObjectClass) {
+			count, err := glib.ParamSpecInt("count", "Count", "A counter", 0, 100, 42, glib.PARAM_READWRITE)
+			if err != nil {
+				t.Fatal("ParamSpecInt failed:", err)
+			}
+			class.InstallProperty(1, count)
+
+			label, err := glib.ParamSpecString("label", "Label", "A label", "none", glib.PARAM_READWRITE)
+			if err != nil {
+				t.Fatal("ParamSpecString failed:", err)
+			}
+			class.InstallProperty(2, label)
+		},
+		InstanceInit: func(obj *glib.Object) *testThing {
+			return &testThing{Object: obj}
+		},
+	})
+	if err != nil {
+		t.Fatal("RegisterType failed:", err)
+	}
+
+	obj, err := glib.ObjectNew(typ)
+	if err != nil {
+		t.Fatal("ObjectNew failed:", err)
+	}
+
+	if pspecs := obj.GetClass().ListProperties(); len(pspecs) != 2 {
+		t.Errorf("Expected 2 properties, got %d", len(pspecs))
+	}
+
+	count, err := obj.GetProperty("count")
+	if err != nil {
+		t.Fatal("GetProperty failed:", err)
+	}
+	if count.(int) != 42 {
+		t.Errorf("Expected default 42, got %v", count)
+	}
+
+	var notified bool
+	obj.Connect("notify::label", func() {
+		notified = true
+	})
+
+	if err := obj.SetProperty("label", "changed"); err != nil {
+		t.Fatal("SetProperty failed:", err)
+	}
+	if !notified {
+		t.Error("Expected notify::label to be emitted")
+	}
+
+	label, err := obj.GetProperty("label")
+	if err != nil {
+		t.Fatal("GetProperty failed:", err)
+	}
+	if label.(string) != "changed" {
+		t.Errorf("Expected \"changed\", got %q", label)
+	}
+}
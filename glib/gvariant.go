@@ -8,7 +8,6 @@ package glib
 import "C"
 
 import (
-	"errors"
 	"fmt"
 	"runtime"
 	"unsafe"
@@ -92,6 +91,17 @@ func takeVariant(p *C.GVariant) *Variant {
 	return obj
 }
 
+// assumeVariant wraps a native GVariant returned with full ownership transfer
+// and sets up a finalizer to free the instance during GC.
+func assumeVariant(p *C.GVariant) *Variant {
+	if p == nil {
+		return nil
+	}
+	obj := &Variant{GVariant: p}
+	runtime.SetFinalizer(obj, func(v *Variant) { FinalizerStrategy(v.Unref) })
+	return obj
+}
+
 // IsFloating returns true if the variant has a floating reference count.
 // Reference counting is usually handled in the gotk layer,
 // most applications should not call this.
@@ -343,20 +353,201 @@ func (v *Variant) AnnotatedString() string {
 	return C.GoString((*C.char)(gc))
 }
 
+// variantArray converts children to a C array of GVariants.
+func variantArray(children []IVariant) []*C.GVariant {
+	arr := make([]*C.GVariant, len(children))
+	for i, child := range children {
+		arr[i] = child.ToGVariant()
+	}
+	return arr
+}
+
+// VariantNewTuple is a wrapper around g_variant_new_tuple().
+func VariantNewTuple(children ...IVariant) *Variant {
+	arr := variantArray(children)
+	var p **C.GVariant
+	if len(arr) > 0 {
+		p = &arr[0]
+	}
+	return takeVariant(C.g_variant_new_tuple(p, C.gsize(len(arr))))
+}
+
+// VariantNewArray is a wrapper around g_variant_new_array(). childType may be
+// nil if children is not empty, all children must have the same type.
+func VariantNewArray(childType *VariantType, children ...IVariant) *Variant {
+	arr := variantArray(children)
+	var p **C.GVariant
+	if len(arr) > 0 {
+		p = &arr[0]
+	}
+	return takeVariant(C.g_variant_new_array(childType.native(), p, C.gsize(len(arr))))
+}
+
+// VariantNewDictEntry is a wrapper around g_variant_new_dict_entry().
+func VariantNewDictEntry(key, value IVariant) *Variant {
+	return takeVariant(C.g_variant_new_dict_entry(key.ToGVariant(), value.ToGVariant()))
+}
+
+// VariantNewMaybe is a wrapper around g_variant_new_maybe(). child is nil for
+// Nothing, in which case childType must be given.
+func VariantNewMaybe(childType *VariantType, child IVariant) *Variant {
+	var c *C.GVariant
+	if child != nil {
+		c = child.ToGVariant()
+	}
+	return takeVariant(C.g_variant_new_maybe(childType.native(), c))
+}
+
+// GetMaybe is a wrapper around g_variant_get_maybe(). It returns nil for
+// Nothing.
+func (v *Variant) GetMaybe() *Variant {
+	return assumeVariant(C.g_variant_get_maybe(v.native()))
+}
+
+// VariantFromObjectPath is a wrapper around g_variant_new_object_path(). It
+// returns an error if path is not a valid D-Bus object path.
+func VariantFromObjectPath(path string) (*Variant, error) {
+	cstr := (*C.gchar)(C.CString(path))
+	defer C.free(unsafe.Pointer(cstr))
+
+	if !gobool(C.g_variant_is_object_path(cstr)) {
+		return nil, fmt.Errorf("invalid object path: %q", path)
+	}
+	return takeVariant(C.g_variant_new_object_path(cstr)), nil
+}
+
+// VariantFromSignature is a wrapper around g_variant_new_signature(). It
+// returns an error if signature is not a valid D-Bus type signature.
+func VariantFromSignature(signature string) (*Variant, error) {
+	cstr := (*C.gchar)(C.CString(signature))
+	defer C.free(unsafe.Pointer(cstr))
+
+	if !gobool(C.g_variant_is_signature(cstr)) {
+		return nil, fmt.Errorf("invalid signature: %q", signature)
+	}
+	return takeVariant(C.g_variant_new_signature(cstr)), nil
+}
+
+// VariantFromHandle is a wrapper around g_variant_new_handle().
+func VariantFromHandle(value int32) *Variant {
+	return takeVariant(C.g_variant_new_handle(C.gint32(value)))
+}
+
+// GetHandle is a wrapper around g_variant_get_handle().
+func (v *Variant) GetHandle() int32 {
+	return int32(C.g_variant_get_handle(v.native()))
+}
+
+// VariantFromBytes is a wrapper around g_variant_new_fixed_array(), returning
+// an "ay" variant holding a copy of b.
+func VariantFromBytes(b []byte) *Variant {
+	if len(b) == 0 {
+		return VariantNewArray(VARIANT_TYPE_BYTE)
+	}
+	c := C.g_variant_new_fixed_array(VARIANT_TYPE_BYTE.native(), C.gconstpointer(unsafe.Pointer(&b[0])), C.gsize(len(b)), 1)
+	return takeVariant(c)
+}
+
+// GetBytes is a wrapper around g_variant_get_fixed_array(). v must be an
+// "ay" variant; a copy of its data is returned.
+func (v *Variant) GetBytes() []byte {
+	var n C.gsize
+	p := C.g_variant_get_fixed_array(v.native(), &n, 1)
+	if n == 0 {
+		return []byte{}
+	}
+	return goByteSlice(unsafe.Pointer(p), n)
+}
+
+// Classify is a wrapper around g_variant_classify().
+func (v *Variant) Classify() VariantClass {
+	return VariantClass(C.g_variant_classify(v.native()))
+}
+
+// NChildren is a wrapper around g_variant_n_children().
+func (v *Variant) NChildren() uint {
+	return uint(C.g_variant_n_children(v.native()))
+}
+
+// GetChildValue is a wrapper around g_variant_get_child_value().
+func (v *Variant) GetChildValue(index uint) *Variant {
+	return assumeVariant(C.g_variant_get_child_value(v.native(), C.gsize(index)))
+}
+
+// LookupValue is a wrapper around g_variant_lookup_value(). v must be a
+// dictionary; nil is returned if key is not found, or if expectedType is not
+// nil and the value is of another type.
+func (v *Variant) LookupValue(key string, expectedType *VariantType) *Variant {
+	cstr := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(cstr))
+
+	return assumeVariant(C.g_variant_lookup_value(v.native(), cstr, expectedType.native()))
+}
+
+// GetSize is a wrapper around g_variant_get_size(). It returns the size in
+// bytes of the serialized form of v.
+func (v *Variant) GetSize() uint {
+	return uint(C.g_variant_get_size(v.native()))
+}
+
+// Store is a wrapper around g_variant_store(). It returns the serialized
+// form of v, in machine byte order, which can be loaded again with
+// VariantFromData.
+func (v *Variant) Store() []byte {
+	size := C.g_variant_get_size(v.native())
+	if size == 0 {
+		return []byte{}
+	}
+	data := make([]byte, size)
+	C.g_variant_store(v.native(), C.gpointer(unsafe.Pointer(&data[0])))
+	return data
+}
+
+// VariantFromData is a wrapper around g_variant_new_from_bytes(). It loads a
+// variant of type typ from its serialized form, copying data. Unless trusted
+// is true, data may be in any form: invalid data is not an error, but
+// results in a default value in place of the invalid parts.
+func VariantFromData(typ *VariantType, data []byte, trusted bool) *Variant {
+	var p C.gconstpointer
+	if len(data) > 0 {
+		p = C.gconstpointer(unsafe.Pointer(&data[0]))
+	}
+	bytes := C.g_bytes_new(p, C.gsize(len(data)))
+	defer C.g_bytes_unref(bytes)
+
+	return takeVariant(C.g_variant_new_from_bytes(typ.native(), bytes, gbool(trusted)))
+}
+
+// GetNormalForm is a wrapper around g_variant_get_normal_form().
+func (v *Variant) GetNormalForm() *Variant {
+	return assumeVariant(C.g_variant_get_normal_form(v.native()))
+}
+
+// IsNormalForm is a wrapper around g_variant_is_normal_form().
+func (v *Variant) IsNormalForm() bool {
+	return gobool(C.g_variant_is_normal_form(v.native()))
+}
+
+// Byteswap is a wrapper around g_variant_byteswap(). It returns v with the
+// byte order of its serialized form swapped, which is used to load data
+// stored on a machine of the other endianness.
+func (v *Variant) Byteswap() *Variant {
+	return assumeVariant(C.g_variant_byteswap(v.native()))
+}
+
+// Equal is a wrapper around g_variant_equal().
+func (v *Variant) Equal(other IVariant) bool {
+	return gobool(C.g_variant_equal(C.gconstpointer(unsafe.Pointer(v.native())), C.gconstpointer(unsafe.Pointer(other.ToGVariant()))))
+}
+
 // TODO:
 //gint	g_variant_compare ()
-//GVariantClass	g_variant_classify ()
 //gboolean	g_variant_check_format_string ()
 //void	g_variant_get ()
 //void	g_variant_get_va ()
 //GVariant *	g_variant_new ()
 //GVariant *	g_variant_new_va ()
-//GVariant *	g_variant_new_handle ()
 //GVariant *	g_variant_new_printf ()
-//GVariant *	g_variant_new_object_path ()
-//gboolean	g_variant_is_object_path ()
-//GVariant *	g_variant_new_signature ()
-//gboolean	g_variant_is_signature ()
 //GVariant *	g_variant_new_strv ()
 //GVariant *	g_variant_new_objv ()
 //GVariant *	g_variant_new_bytestring ()
@@ -368,68 +559,29 @@ func (v *Variant) AnnotatedString() string {
 //guint32	g_variant_get_uint32 ()
 //gint64	g_variant_get_int64 ()
 //guint64	g_variant_get_uint64 ()
-//gint32	g_variant_get_handle ()
 //gdouble	g_variant_get_double ()
 //const gchar *	g_variant_get_bytestring ()
 //gchar *	g_variant_dup_bytestring ()
 //const gchar **	g_variant_get_bytestring_array ()
 //gchar **	g_variant_dup_bytestring_array ()
-//GVariant *	g_variant_new_maybe ()
-//GVariant *	g_variant_new_array ()
-//GVariant *	g_variant_new_tuple ()
-//GVariant *	g_variant_new_dict_entry ()
-//GVariant *	g_variant_new_fixed_array ()
-//GVariant *	g_variant_get_maybe ()
-//gsize	g_variant_n_children ()
-//GVariant *	g_variant_get_child_value ()
 //void	g_variant_get_child ()
-//GVariant *	g_variant_lookup_value ()
 //gboolean	g_variant_lookup ()
-//gconstpointer	g_variant_get_fixed_array ()
-//gsize	g_variant_get_size ()
 //gconstpointer	g_variant_get_data ()
 //GBytes *	g_variant_get_data_as_bytes ()
-//void	g_variant_store ()
-//GVariant *	g_variant_new_from_data ()
-//GVariant *	g_variant_new_from_bytes ()
-//GVariant *	g_variant_byteswap ()
-//GVariant *	g_variant_get_normal_form ()
-//gboolean	g_variant_is_normal_form ()
 //guint	g_variant_hash ()
-//gboolean	g_variant_equal ()
 //gchar *	g_variant_print ()
 //GString *	g_variant_print_string ()
-//GVariantIter *	g_variant_iter_copy ()
-//void	g_variant_iter_free ()
 //gsize	g_variant_iter_init ()
-//gsize	g_variant_iter_n_children ()
-//GVariantIter *	g_variant_iter_new ()
-//GVariant *	g_variant_iter_next_value ()
 //gboolean	g_variant_iter_next ()
 //gboolean	g_variant_iter_loop ()
-//void	g_variant_builder_unref ()
-//GVariantBuilder *	g_variant_builder_ref ()
-//GVariantBuilder *	g_variant_builder_new ()
 //void	g_variant_builder_init ()
 //void	g_variant_builder_clear ()
-//void	g_variant_builder_add_value ()
 //void	g_variant_builder_add ()
 //void	g_variant_builder_add_parsed ()
-//GVariant *	g_variant_builder_end ()
-//void	g_variant_builder_open ()
-//void	g_variant_builder_close ()
-//void	g_variant_dict_unref ()
-//GVariantDict *	g_variant_dict_ref ()
-//GVariantDict *	g_variant_dict_new ()
 //void	g_variant_dict_init ()
 //void	g_variant_dict_clear ()
-//gboolean	g_variant_dict_contains ()
 //gboolean	g_variant_dict_lookup ()
-//GVariant *	g_variant_dict_lookup_value ()
 //void	g_variant_dict_insert ()
-//void	g_variant_dict_insert_value ()
-//gboolean	g_variant_dict_remove ()
-//GVariant *	g_variant_dict_end ()
 //#define	G_VARIANT_PARSE_ERROR
 
 // VariantParse is a wrapper around g_variant_parse()
@@ -439,8 +591,7 @@ func VariantParse(vType *VariantType, text string) (*Variant, error) {
 	var gerr *C.GError
 	c := C.g_variant_parse(vType.native(), (*C.gchar)(cstr), nil, nil, &gerr)
 	if c == nil {
-		defer C.g_error_free(gerr)
-		return nil, errors.New(goString(gerr.message))
+		return nil, takeError(gerr)
 	}
 	// will be freed during GC
 	return takeVariant(c), nil
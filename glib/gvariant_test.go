@@ -4,6 +4,7 @@ package glib_test
 
 import (
 	"math"
+	"reflect"
 	"testing"
 
 	"github.com/gotk3/gotk3/glib"
@@ -186,3 +187,254 @@ func TestVariantVariant(t *testing.T) {
 		t.Error("Expected", boxed.Native(), "got", actual.Native())
 	}
 }
+
+func TestVariantBuilder(t *testing.T) {
+	builder := glib.VariantBuilderNew(glib.VariantTypeNew("(sai)"))
+	builder.AddValue(glib.VariantFromString("numbers"))
+	builder.Open(glib.VariantTypeNew("ai"))
+	builder.AddValue(glib.VariantFromInt32(1))
+	builder.AddValue(glib.VariantFromInt32(2))
+	builder.Close()
+
+	variant := builder.End()
+	if s := variant.String(); s != "('numbers', [1, 2])" {
+		t.Error("Expected ('numbers', [1, 2]), got", s)
+	}
+	if n := variant.GetChildValue(1).NChildren(); n != 2 {
+		t.Error("Expected 2 children, got", n)
+	}
+}
+
+func TestVariantDict(t *testing.T) {
+	dict := glib.VariantDictNew(nil)
+	dict.InsertValue("name", glib.VariantFromString("gotk3"))
+	dict.InsertValue("answer", glib.VariantFromInt32(42))
+
+	if !dict.Contains("name") {
+		t.Error("Expected dict to contain name")
+	}
+	if dict.LookupValue("answer", glib.VARIANT_TYPE_STRING) != nil {
+		t.Error("Expected no value for mismatched type")
+	}
+	if !dict.Remove("name") || dict.Contains("name") {
+		t.Error("Expected name to be removed")
+	}
+
+	variant := dict.End()
+	if !variant.IsType(glib.VARIANT_TYPE_VARDICT) {
+		t.Error("Expected a{sv}, got", variant.TypeString())
+	}
+
+	answer := variant.LookupValue("answer", glib.VARIANT_TYPE_INT32)
+	if answer == nil {
+		t.Fatal("Expected answer in dictionary")
+	}
+	if i, _ := answer.GetInt(); i != 42 {
+		t.Error("Expected 42, got", i)
+	}
+}
+
+func TestVariantIter(t *testing.T) {
+	variant := glib.VariantNewArray(nil,
+		glib.VariantFromString("a"),
+		glib.VariantFromString("b"),
+		glib.VariantFromString("c"))
+
+	iter := variant.Iter()
+	if n := iter.NChildren(); n != 3 {
+		t.Error("Expected 3 children, got", n)
+	}
+
+	var got []string
+	for child, ok := iter.NextValue(); ok; child, ok = iter.NextValue() {
+		got = append(got, child.GetString())
+	}
+	if len(got) != 3 || got[0] != "a" || got[2] != "c" {
+		t.Error("Expected [a b c], got", got)
+	}
+
+	tuple := glib.VariantNewTuple(glib.VariantFromBoolean(true), variant)
+	if tuple.TypeString() != "(bas)" {
+		t.Error("Expected (bas), got", tuple.TypeString())
+	}
+}
+
+type testVariantPayload struct {
+	Name    string
+	Path    string `gvariant:"o"`
+	Count   int32
+	Tags    []string
+	Extra   map[string]interface{}
+	Parent  *string
+	ignored bool
+	Skipped int `gvariant:"-"`
+}
+
+func TestVariantFromGo(t *testing.T) {
+	payload := testVariantPayload{
+		Name:  "gotk3",
+		Path:  "/org/gotk3/Test",
+		Count: 3,
+		Tags:  []string{"a", "b"},
+		Extra: map[string]interface{}{"enabled": true, "ratio": 0.5},
+	}
+
+	variant, err := glib.VariantFromGo(payload)
+	if err != nil {
+		t.Fatal("VariantFromGo failed:", err)
+	}
+	if s := variant.TypeString(); s != "(soiasa{sv}ms)" {
+		t.Fatal("Expected (soiasa{sv}ms), got", s)
+	}
+
+	var decoded testVariantPayload
+	if err := variant.Decode(&decoded); err != nil {
+		t.Fatal("Decode failed:", err)
+	}
+	if decoded.Name != payload.Name || decoded.Path != payload.Path || decoded.Count != payload.Count {
+		t.Errorf("Expected %+v, got %+v", payload, decoded)
+	}
+	if len(decoded.Tags) != 2 || decoded.Tags[1] != "b" {
+		t.Error("Expected [a b], got", decoded.Tags)
+	}
+	if decoded.Extra["enabled"] != true || decoded.Extra["ratio"] != 0.5 {
+		t.Error("Unexpected extra values:", decoded.Extra)
+	}
+	if decoded.Parent != nil {
+		t.Error("Expected nil parent, got", *decoded.Parent)
+	}
+}
+
+func TestVariantFromGoIntegerRange(t *testing.T) {
+	tests := []struct {
+		class string
+		value interface{}
+		ok    bool
+	}{
+		{"y", 0, true},
+		{"y", 255, true},
+		{"y", 256, false},
+		{"y", -1, false},
+		{"n", -1 << 15, true},
+		{"n", 1<<15 - 1, true},
+		{"n", 1 << 15, false},
+		{"n", -1<<15 - 1, false},
+		{"q", 1<<16 - 1, true},
+		{"q", 1 << 16, false},
+		{"q", -1, false},
+		{"i", int64(-1 << 31), true},
+		{"i", int64(1<<31 - 1), true},
+		{"i", int64(1 << 31), false},
+		{"i", int64(1 << 40), false},
+		{"u", uint64(1<<32 - 1), true},
+		{"u", uint64(1 << 32), false},
+		{"u", -1, false},
+		{"x", int64(-1 << 63), true},
+		{"x", uint64(1<<63 - 1), true},
+		{"x", uint64(1 << 63), false},
+		{"t", uint64(1<<64 - 1), true},
+		{"t", -1, false},
+		{"h", int64(1 << 31), false},
+	}
+
+	for _, test := range tests {
+		// A struct with a single field of the type of value, tagged with
+		// the class.
+		typ := reflect.StructOf([]reflect.StructField{{
+			Name: "V",
+			Type: reflect.TypeOf(test.value),
+			Tag:  reflect.StructTag(`gvariant:"` + test.class + `"`),
+		}})
+		v := reflect.New(typ).Elem()
+		v.Field(0).Set(reflect.ValueOf(test.value))
+
+		variant, err := glib.VariantFromGo(v.Interface())
+		if !test.ok {
+			if err == nil {
+				t.Errorf("Expected %v to overflow %s", test.value, test.class)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("VariantFromGo failed for %v as %s: %v", test.value, test.class, err)
+			continue
+		}
+		if s := variant.TypeString(); s != "("+test.class+")" {
+			t.Errorf("Expected (%s), got %s", test.class, s)
+			continue
+		}
+		decoded := reflect.New(typ)
+		if err := variant.Decode(decoded.Interface()); err != nil {
+			t.Errorf("Decode failed for %v as %s: %v", test.value, test.class, err)
+			continue
+		}
+		if got := decoded.Elem().Field(0).Interface(); got != test.value {
+			t.Errorf("Expected %v as %s, got %v", test.value, test.class, got)
+		}
+	}
+}
+
+func TestVariantDecode(t *testing.T) {
+	variant, err := glib.VariantParse(nil, "{'width': <int32 640>, 'height': <int32 480>}")
+	if err != nil {
+		t.Fatal("VariantParse failed:", err)
+	}
+
+	var sizes map[string]int
+	if err := variant.Decode(&sizes); err != nil {
+		t.Fatal("Decode failed:", err)
+	}
+	if sizes["width"] != 640 || sizes["height"] != 480 {
+		t.Error("Unexpected sizes:", sizes)
+	}
+
+	var small map[string]uint8
+	if err := variant.Decode(&small); err == nil {
+		t.Error("Expected overflow error")
+	}
+
+	var generic interface{}
+	if err := glib.VariantFromInt32(7).Decode(&generic); err != nil {
+		t.Fatal("Decode failed:", err)
+	}
+	if generic != int32(7) {
+		t.Errorf("Expected int32(7), got %#v", generic)
+	}
+}
+
+func TestVariantStore(t *testing.T) {
+	variant, err := glib.VariantParse(nil, "('gotk3', uint32 7, [true, false])")
+	if err != nil {
+		t.Fatal("VariantParse failed:", err)
+	}
+
+	data := variant.Store()
+	if uint(len(data)) != variant.GetSize() {
+		t.Errorf("Expected %d bytes, got %d", variant.GetSize(), len(data))
+	}
+
+	loaded := glib.VariantFromData(variant.Type(), data, false)
+	if !loaded.Equal(variant) {
+		t.Error("Expected", variant, "got", loaded)
+	}
+	if !loaded.IsNormalForm() {
+		t.Error("Expected stored data to be in normal form")
+	}
+
+	swapped := loaded.Byteswap()
+	if swapped.Equal(variant) {
+		t.Error("Expected byteswapped uint32 to differ")
+	}
+	if !swapped.Byteswap().Equal(variant) {
+		t.Error("Expected double byteswap to restore the value")
+	}
+
+	// Not in normal form: the array is truncated.
+	broken := glib.VariantFromData(glib.VariantTypeNew("ai"), []byte{1, 0, 0}, false)
+	if broken.IsNormalForm() {
+		t.Error("Expected truncated data not to be in normal form")
+	}
+	if !broken.GetNormalForm().IsNormalForm() {
+		t.Error("Expected GetNormalForm to return normal form")
+	}
+}
@@ -10,7 +10,10 @@ package glib
 // #include "glib.go.h"
 // #include "gvariant.go.h"
 import "C"
-import "unsafe"
+import (
+	"runtime"
+	"unsafe"
+)
 
 /*
  * GVariantBuilder
@@ -50,3 +53,48 @@ func (v *VariantBuilder) native() *C.GVariantBuilder {
 func (v *VariantBuilder) Native() uintptr {
 	return uintptr(unsafe.Pointer(v.native()))
 }
+
+// VariantBuilderNew is a wrapper around g_variant_builder_new(). typ is the
+// type of the container to build, e.g. VARIANT_TYPE_VARDICT or "(si)".
+func VariantBuilderNew(typ *VariantType) *VariantBuilder {
+	c := C.g_variant_builder_new(typ.native())
+	v := newVariantBuilder(c)
+	runtime.SetFinalizer(v, func(v *VariantBuilder) { FinalizerStrategy(v.Unref) })
+	return v
+}
+
+// Ref is a wrapper around g_variant_builder_ref().
+// Reference counting is usually handled in the gotk layer,
+// most applications should not need to call this.
+func (v *VariantBuilder) Ref() {
+	C.g_variant_builder_ref(v.native())
+}
+
+// Unref is a wrapper around g_variant_builder_unref().
+// Reference counting is usually handled in the gotk layer,
+// most applications should not need to call this.
+func (v *VariantBuilder) Unref() {
+	C.g_variant_builder_unref(v.native())
+}
+
+// AddValue is a wrapper around g_variant_builder_add_value().
+func (v *VariantBuilder) AddValue(value IVariant) {
+	C.g_variant_builder_add_value(v.native(), value.ToGVariant())
+}
+
+// Open is a wrapper around g_variant_builder_open(). Values added until the
+// matching Close are part of a new child container of type typ.
+func (v *VariantBuilder) Open(typ *VariantType) {
+	C.g_variant_builder_open(v.native(), typ.native())
+}
+
+// Close is a wrapper around g_variant_builder_close().
+func (v *VariantBuilder) Close() {
+	C.g_variant_builder_close(v.native())
+}
+
+// End is a wrapper around g_variant_builder_end(). It returns the built
+// container; the builder can be used again afterwards.
+func (v *VariantBuilder) End() *Variant {
+	return takeVariant(C.g_variant_builder_end(v.native()))
+}
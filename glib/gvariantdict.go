@@ -10,7 +10,10 @@ package glib
 // #include "glib.go.h"
 // #include "gvariant.go.h"
 import "C"
-import "unsafe"
+import (
+	"runtime"
+	"unsafe"
+)
 
 /*
  * GVariantDict
@@ -37,6 +40,17 @@ func newVariantDict(p *C.GVariantDict) *VariantDict {
 	return &VariantDict{GVariantDict: p}
 }
 
+// takeVariantDict wraps a GVariantDict returned without ownership transfer,
+// taking a reference and setting up a finalizer to release it during GC.
+func takeVariantDict(p *C.GVariantDict) *VariantDict {
+	if p == nil {
+		return nil
+	}
+	v := newVariantDict(C.g_variant_dict_ref(p))
+	runtime.SetFinalizer(v, func(v *VariantDict) { FinalizerStrategy(v.Unref) })
+	return v
+}
+
 // native returns a pointer to the underlying GVariantDict.
 func (v *VariantDict) native() *C.GVariantDict {
 	if v == nil || v.GVariantDict == nil {
@@ -50,3 +64,68 @@ func (v *VariantDict) native() *C.GVariantDict {
 func (v *VariantDict) Native() uintptr {
 	return uintptr(unsafe.Pointer(v.native()))
 }
+
+// VariantDictNew is a wrapper around g_variant_dict_new(). from is an a{sv}
+// variant holding the initial entries, or nil for an empty dictionary.
+func VariantDictNew(from *Variant) *VariantDict {
+	c := C.g_variant_dict_new(from.native())
+	v := newVariantDict(c)
+	runtime.SetFinalizer(v, func(v *VariantDict) { FinalizerStrategy(v.Unref) })
+	return v
+}
+
+// Ref is a wrapper around g_variant_dict_ref().
+// Reference counting is usually handled in the gotk layer,
+// most applications should not need to call this.
+func (v *VariantDict) Ref() {
+	C.g_variant_dict_ref(v.native())
+}
+
+// Unref is a wrapper around g_variant_dict_unref().
+// Reference counting is usually handled in the gotk layer,
+// most applications should not need to call this.
+func (v *VariantDict) Unref() {
+	C.g_variant_dict_unref(v.native())
+}
+
+// Contains is a wrapper around g_variant_dict_contains().
+func (v *VariantDict) Contains(key string) bool {
+	cstr := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(cstr))
+
+	return gobool(C.g_variant_dict_contains(v.native(), cstr))
+}
+
+// LookupValue is a wrapper around g_variant_dict_lookup_value(). It returns
+// nil if key is not in the dictionary, or if expectedType is not nil and the
+// value is of another type.
+func (v *VariantDict) LookupValue(key string, expectedType *VariantType) *Variant {
+	cstr := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_variant_dict_lookup_value(v.native(), cstr, expectedType.native())
+	return assumeVariant(c)
+}
+
+// InsertValue is a wrapper around g_variant_dict_insert_value().
+func (v *VariantDict) InsertValue(key string, value IVariant) {
+	cstr := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_variant_dict_insert_value(v.native(), cstr, value.ToGVariant())
+}
+
+// Remove is a wrapper around g_variant_dict_remove(). It returns whether key
+// was in the dictionary.
+func (v *VariantDict) Remove(key string) bool {
+	cstr := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(cstr))
+
+	return gobool(C.g_variant_dict_remove(v.native(), cstr))
+}
+
+// End is a wrapper around g_variant_dict_end(). It returns the dictionary as
+// an a{sv} variant and clears the VariantDict.
+func (v *VariantDict) End() *Variant {
+	return takeVariant(C.g_variant_dict_end(v.native()))
+}
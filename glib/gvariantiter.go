@@ -10,7 +10,10 @@ package glib
 // #include "glib.go.h"
 // #include "gvariant.go.h"
 import "C"
-import "unsafe"
+import (
+	"runtime"
+	"unsafe"
+)
 
 /*
  * GVariantIter
@@ -50,3 +53,46 @@ func (v *VariantIter) native() *C.GVariantIter {
 func (v *VariantIter) Native() uintptr {
 	return uintptr(unsafe.Pointer(v.native()))
 }
+
+// VariantIterNew is a wrapper around g_variant_iter_new(). value must be a
+// container.
+func VariantIterNew(value IVariant) *VariantIter {
+	c := C.g_variant_iter_new(value.ToGVariant())
+	v := newVariantIter(c)
+	runtime.SetFinalizer(v, func(v *VariantIter) { FinalizerStrategy(v.free) })
+	return v
+}
+
+// Iter is a wrapper around g_variant_iter_new(), returning an iterator over
+// the children of the container v.
+func (v *Variant) Iter() *VariantIter {
+	return VariantIterNew(v)
+}
+
+func (v *VariantIter) free() {
+	C.g_variant_iter_free(v.native())
+}
+
+// Copy is a wrapper around g_variant_iter_copy(). The copy starts at the
+// current position of v.
+func (v *VariantIter) Copy() *VariantIter {
+	c := C.g_variant_iter_copy(v.native())
+	iter := newVariantIter(c)
+	runtime.SetFinalizer(iter, func(v *VariantIter) { FinalizerStrategy(v.free) })
+	return iter
+}
+
+// NChildren is a wrapper around g_variant_iter_n_children().
+func (v *VariantIter) NChildren() uint {
+	return uint(C.g_variant_iter_n_children(v.native()))
+}
+
+// NextValue is a wrapper around g_variant_iter_next_value(). The second
+// return value is false once all children have been returned.
+func (v *VariantIter) NextValue() (*Variant, bool) {
+	c := C.g_variant_iter_next_value(v.native())
+	if c == nil {
+		return nil, false
+	}
+	return assumeVariant(c), true
+}
@@ -0,0 +1,779 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+/*
+ * Conversion between Go values and GVariant
+ */
+
+var variantPtrType = reflect.TypeOf((*Variant)(nil))
+
+// VariantFromGo converts a Go value to a Variant. The GVariant type is
+// derived from the Go type:
+//
+//	bool                        b
+//	uint8, int16, uint16        y, n, q
+//	int32, uint32               i, u
+//	int, int64, uint, uint64    x, t
+//	float32, float64            d
+//	string                      s
+//	[]byte                      ay
+//	[]T, [n]T                   aT
+//	map[K]V                     a{KV}, e.g. a{sv} for map[string]interface{}
+//	struct                      tuple of the exported fields, e.g. (si)
+//	*T                          mT, nil being Nothing
+//	interface{}, *Variant       v
+//
+// The type of a struct field can be set with a `gvariant` tag holding a type
+// string, e.g. `gvariant:"o"` to encode a string as an object path or
+// `gvariant:"h"` for an int32 handle. Fields tagged `gvariant:"-"` are
+// skipped.
+//
+// A *Variant passed to VariantFromGo is returned as is.
+func VariantFromGo(value interface{}) (*Variant, error) {
+	if v, ok := value.(*Variant); ok {
+		return v, nil
+	}
+	if value == nil {
+		return nil, errors.New("cannot convert nil to a variant")
+	}
+
+	rv := reflect.ValueOf(value)
+	sig, err := variantTypeOf(rv.Type())
+	if err != nil {
+		return nil, err
+	}
+	return variantFromValue(rv, sig)
+}
+
+// VariantTypeOf returns the type string of the Variant VariantFromGo creates
+// for values of Go type t.
+func VariantTypeOf(t reflect.Type) (string, error) {
+	return variantTypeOf(t)
+}
+
+// Decode stores the value of v in the Go value pointed to by ptr, following
+// the mapping described for VariantFromGo. Integers may be decoded into any
+// integer type they fit in, variants are unboxed as needed and decoding into
+// an interface{} yields the natural Go value: map[string]interface{} for a
+// dictionary with string keys, []interface{} for other arrays and tuples.
+func (v *Variant) Decode(ptr interface{}) error {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("cannot decode into %T, need a non-nil pointer", ptr)
+	}
+	return v.decodeInto(rv.Elem())
+}
+
+// variantField is an exported struct field taking part in the conversion.
+type variantField struct {
+	index int
+	sig   string
+}
+
+// variantFields returns the fields of struct type t which are converted, in
+// order.
+func variantFields(t reflect.Type) ([]variantField, error) {
+	var fields []variantField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+
+		tag := f.Tag.Get("gvariant")
+		if tag == "-" {
+			continue
+		}
+		if tag != "" && !VariantTypeStringIsValid(tag) {
+			return nil, fmt.Errorf("field %s: invalid variant type %q", f.Name, tag)
+		}
+
+		fields = append(fields, variantField{i, tag})
+	}
+	return fields, nil
+}
+
+func variantTypeOf(t reflect.Type) (string, error) {
+	if t == variantPtrType {
+		return "v", nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return "b", nil
+	case reflect.Uint8:
+		return "y", nil
+	case reflect.Int8, reflect.Int16:
+		return "n", nil
+	case reflect.Uint16:
+		return "q", nil
+	case reflect.Int32:
+		return "i", nil
+	case reflect.Uint32:
+		return "u", nil
+	case reflect.Int, reflect.Int64:
+		return "x", nil
+	case reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		return "t", nil
+	case reflect.Float32, reflect.Float64:
+		return "d", nil
+	case reflect.String:
+		return "s", nil
+	case reflect.Interface:
+		return "v", nil
+
+	case reflect.Ptr:
+		elem, err := variantTypeOf(t.Elem())
+		if err != nil {
+			return "", err
+		}
+		return "m" + elem, nil
+
+	case reflect.Slice, reflect.Array:
+		elem, err := variantTypeOf(t.Elem())
+		if err != nil {
+			return "", err
+		}
+		return "a" + elem, nil
+
+	case reflect.Map:
+		key, err := variantTypeOf(t.Key())
+		if err != nil {
+			return "", err
+		}
+		if len(key) != 1 || key == "v" {
+			return "", fmt.Errorf("map key type %s is not a basic variant type", t.Key())
+		}
+		val, err := variantTypeOf(t.Elem())
+		if err != nil {
+			return "", err
+		}
+		return "a{" + key + val + "}", nil
+
+	case reflect.Struct:
+		fields, err := variantFields(t)
+		if err != nil {
+			return "", err
+		}
+		sig := "("
+		for _, f := range fields {
+			if f.sig == "" {
+				f.sig, err = variantTypeOf(t.Field(f.index).Type)
+				if err != nil {
+					return "", err
+				}
+			}
+			sig += f.sig
+		}
+		return sig + ")", nil
+	}
+
+	return "", fmt.Errorf("no variant type for Go type %s", t)
+}
+
+// splitVariantType splits the first complete type off a string of
+// concatenated variant types, such as the contents of a tuple type.
+func splitVariantType(sig string) (first, rest string) {
+	depth := 0
+	for i := 0; i < len(sig); i++ {
+		switch sig[i] {
+		case 'a', 'm':
+			continue
+		case '(', '{':
+			depth++
+		case ')', '}':
+			depth--
+		}
+		if depth == 0 {
+			return sig[:i+1], sig[i+1:]
+		}
+	}
+	return sig, ""
+}
+
+// variantFromValue converts rv to a Variant of type sig.
+func variantFromValue(rv reflect.Value, sig string) (*Variant, error) {
+	if rv.Type() == variantPtrType {
+		v := rv.Interface().(*Variant)
+		if v == nil {
+			return nil, errors.New("cannot convert nil *Variant")
+		}
+		if sig == "v" {
+			return VariantFromVariant(v), nil
+		}
+		if v.TypeString() != sig {
+			return nil, fmt.Errorf("variant of type %s is not a %s", v.TypeString(), sig)
+		}
+		return v, nil
+	}
+
+	if rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			if sig[0] == 'm' {
+				return VariantNewMaybe(VariantTypeNew(sig[1:]), nil), nil
+			}
+			return nil, fmt.Errorf("cannot convert nil to %s", sig)
+		}
+		rv = rv.Elem()
+		if sig == "v" {
+			inner, err := variantTypeOf(rv.Type())
+			if err != nil {
+				return nil, err
+			}
+			if inner == "v" {
+				// A *Variant, which is boxed only once.
+				return variantFromValue(rv, inner)
+			}
+			v, err := variantFromValue(rv, inner)
+			if err != nil {
+				return nil, err
+			}
+			return VariantFromVariant(v), nil
+		}
+		return variantFromValue(rv, sig)
+	}
+
+	switch sig[0] {
+	case 'b':
+		if rv.Kind() == reflect.Bool {
+			return VariantFromBoolean(rv.Bool()), nil
+		}
+
+	case 'y', 'n', 'q', 'i', 'u', 'x', 't', 'h':
+		return variantFromInteger(rv, sig[0])
+
+	case 'd':
+		switch rv.Kind() {
+		case reflect.Float32, reflect.Float64:
+			return VariantFromFloat64(rv.Float()), nil
+		}
+
+	case 's', 'o', 'g':
+		if rv.Kind() != reflect.String {
+			break
+		}
+		switch sig[0] {
+		case 'o':
+			return VariantFromObjectPath(rv.String())
+		case 'g':
+			return VariantFromSignature(rv.String())
+		}
+		return VariantFromString(rv.String()), nil
+
+	case 'v':
+		inner, err := variantTypeOf(rv.Type())
+		if err != nil {
+			return nil, err
+		}
+		v, err := variantFromValue(rv, inner)
+		if err != nil {
+			return nil, err
+		}
+		return VariantFromVariant(v), nil
+
+	case 'm':
+		if rv.Kind() == reflect.Ptr {
+			if rv.IsNil() {
+				return VariantNewMaybe(VariantTypeNew(sig[1:]), nil), nil
+			}
+			rv = rv.Elem()
+		}
+		child, err := variantFromValue(rv, sig[1:])
+		if err != nil {
+			return nil, err
+		}
+		return VariantNewMaybe(nil, child), nil
+
+	case 'a':
+		return variantFromArray(rv, sig)
+
+	case '(':
+		return variantFromTuple(rv, sig)
+
+	case '{':
+		if rv.Kind() != reflect.Struct {
+			break
+		}
+		children, err := variantChildren(rv, sig)
+		if err != nil {
+			return nil, err
+		}
+		if len(children) != 2 {
+			return nil, fmt.Errorf("cannot convert %s to %s", rv.Type(), sig)
+		}
+		return VariantNewDictEntry(children[0], children[1]), nil
+	}
+
+	return nil, fmt.Errorf("cannot convert %s to %s", rv.Type(), sig)
+}
+
+func variantFromInteger(rv reflect.Value, class byte) (*Variant, error) {
+	var (
+		signed bool
+		i      int64
+		u      uint64
+	)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		signed, i = true, rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u = rv.Uint()
+	default:
+		return nil, fmt.Errorf("cannot convert %s to %c", rv.Type(), class)
+	}
+
+	min, max := integerRange(class)
+	if signed && (i < min || i > 0 && uint64(i) > max) {
+		return nil, fmt.Errorf("%s value %d overflows %c", rv.Type(), i, class)
+	}
+	if !signed && u > max {
+		return nil, fmt.Errorf("%s value %d overflows %c", rv.Type(), u, class)
+	}
+	if signed {
+		u = uint64(i)
+	} else {
+		i = int64(u)
+	}
+
+	switch class {
+	case 'y':
+		return VariantFromByte(uint8(u)), nil
+	case 'n':
+		return VariantFromInt16(int16(i)), nil
+	case 'q':
+		return VariantFromUint16(uint16(u)), nil
+	case 'i':
+		return VariantFromInt32(int32(i)), nil
+	case 'u':
+		return VariantFromUint32(uint32(u)), nil
+	case 'x':
+		return VariantFromInt64(i), nil
+	case 't':
+		return VariantFromUint64(u), nil
+	default:
+		return VariantFromHandle(int32(i)), nil
+	}
+}
+
+// integerRange returns the bounds of the integer type class.
+func integerRange(class byte) (min int64, max uint64) {
+	switch class {
+	case 'y':
+		return 0, 1<<8 - 1
+	case 'n':
+		return -1 << 15, 1<<15 - 1
+	case 'q':
+		return 0, 1<<16 - 1
+	case 'u':
+		return 0, 1<<32 - 1
+	case 'x':
+		return -1 << 63, 1<<63 - 1
+	case 't':
+		return 0, 1<<64 - 1
+	default: // 'i' and 'h'
+		return -1 << 31, 1<<31 - 1
+	}
+}
+
+func variantFromArray(rv reflect.Value, sig string) (*Variant, error) {
+	elemSig := sig[1:]
+
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		if elemSig == "y" && rv.Type().Elem().Kind() == reflect.Uint8 && rv.Kind() == reflect.Slice {
+			return VariantFromBytes(rv.Bytes()), nil
+		}
+
+		children := make([]IVariant, rv.Len())
+		for i := range children {
+			child, err := variantFromValue(rv.Index(i), elemSig)
+			if err != nil {
+				return nil, err
+			}
+			children[i] = child
+		}
+		return VariantNewArray(VariantTypeNew(elemSig), children...), nil
+
+	case reflect.Map:
+		if elemSig[0] != '{' {
+			break
+		}
+		keySig, valSig := splitVariantType(elemSig[1 : len(elemSig)-1])
+
+		keys := rv.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return variantKeyLess(keys[i], keys[j]) })
+
+		children := make([]IVariant, len(keys))
+		for i, key := range keys {
+			k, err := variantFromValue(key, keySig)
+			if err != nil {
+				return nil, err
+			}
+			val, err := variantFromValue(rv.MapIndex(key), valSig)
+			if err != nil {
+				return nil, err
+			}
+			children[i] = VariantNewDictEntry(k, val)
+		}
+		return VariantNewArray(VariantTypeNew(elemSig), children...), nil
+	}
+
+	return nil, fmt.Errorf("cannot convert %s to %s", rv.Type(), sig)
+}
+
+// variantKeyLess orders map keys, so that dictionaries are created in a
+// stable order.
+func variantKeyLess(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.String:
+		return a.String() < b.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() < b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() < b.Float()
+	case reflect.Bool:
+		return !a.Bool() && b.Bool()
+	}
+	return false
+}
+
+func variantFromTuple(rv reflect.Value, sig string) (*Variant, error) {
+	children, err := variantChildren(rv, sig)
+	if err != nil {
+		return nil, err
+	}
+	return VariantNewTuple(children...), nil
+}
+
+// variantChildren converts the fields of struct rv, or the elements of slice
+// rv, to the member types of the tuple or dict entry type sig.
+func variantChildren(rv reflect.Value, sig string) ([]IVariant, error) {
+	var values []reflect.Value
+	var tags []string
+
+	switch rv.Kind() {
+	case reflect.Struct:
+		fields, err := variantFields(rv.Type())
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range fields {
+			values = append(values, rv.Field(f.index))
+			tags = append(tags, f.sig)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			values = append(values, rv.Index(i))
+			tags = append(tags, "")
+		}
+	default:
+		return nil, fmt.Errorf("cannot convert %s to %s", rv.Type(), sig)
+	}
+
+	var children []IVariant
+	rest := sig[1 : len(sig)-1]
+	for i, value := range values {
+		if rest == "" {
+			return nil, fmt.Errorf("cannot convert %s to %s: too many members", rv.Type(), sig)
+		}
+		var childSig string
+		childSig, rest = splitVariantType(rest)
+		if tags[i] != "" && tags[i] != childSig {
+			return nil, fmt.Errorf("cannot convert %s to %s: member %d is tagged %s", rv.Type(), sig, i, tags[i])
+		}
+
+		child, err := variantFromValue(value, childSig)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+	}
+	if rest != "" {
+		return nil, fmt.Errorf("cannot convert %s to %s: too few members", rv.Type(), sig)
+	}
+	return children, nil
+}
+
+// decodeInto stores the value of v in rv, which must be settable.
+func (v *Variant) decodeInto(rv reflect.Value) error {
+	if rv.Type() == variantPtrType {
+		rv.Set(reflect.ValueOf(v))
+		return nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Interface:
+		if rv.NumMethod() != 0 {
+			return fmt.Errorf("cannot decode %s into %s", v.TypeString(), rv.Type())
+		}
+		if val := v.goValue(); val != nil {
+			rv.Set(reflect.ValueOf(val))
+		} else {
+			rv.Set(reflect.Zero(rv.Type()))
+		}
+		return nil
+
+	case reflect.Ptr:
+		if v.Classify() == VARIANT_CLASS_MAYBE {
+			child := v.GetMaybe()
+			if child == nil {
+				rv.Set(reflect.Zero(rv.Type()))
+				return nil
+			}
+			v = child
+		}
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		return v.decodeInto(rv.Elem())
+	}
+
+	switch v.Classify() {
+	case VARIANT_CLASS_VARIANT:
+		return v.GetVariant().decodeInto(rv)
+
+	case VARIANT_CLASS_MAYBE:
+		child := v.GetMaybe()
+		if child == nil {
+			rv.Set(reflect.Zero(rv.Type()))
+			return nil
+		}
+		return child.decodeInto(rv)
+
+	case VARIANT_CLASS_BOOLEAN:
+		if rv.Kind() == reflect.Bool {
+			rv.SetBool(v.GetBoolean())
+			return nil
+		}
+
+	case VARIANT_CLASS_BYTE, VARIANT_CLASS_UINT16, VARIANT_CLASS_UINT32, VARIANT_CLASS_UINT64:
+		u, _ := v.GetUint()
+		return decodeInteger(rv, false, 0, u, v.TypeString())
+
+	case VARIANT_CLASS_INT16, VARIANT_CLASS_INT32, VARIANT_CLASS_INT64:
+		i, _ := v.GetInt()
+		return decodeInteger(rv, true, i, 0, v.TypeString())
+
+	case VARIANT_CLASS_HANDLE:
+		return decodeInteger(rv, true, int64(v.GetHandle()), 0, v.TypeString())
+
+	case VARIANT_CLASS_DOUBLE:
+		switch rv.Kind() {
+		case reflect.Float32, reflect.Float64:
+			rv.SetFloat(v.GetDouble())
+			return nil
+		}
+
+	case VARIANT_CLASS_STRING, VARIANT_CLASS_OBJECT_PATH, VARIANT_CLASS_SIGNATURE:
+		if rv.Kind() == reflect.String {
+			rv.SetString(v.GetString())
+			return nil
+		}
+
+	case VARIANT_CLASS_ARRAY:
+		return v.decodeArray(rv)
+
+	case VARIANT_CLASS_TUPLE, VARIANT_CLASS_DICT_ENTRY:
+		return v.decodeTuple(rv)
+	}
+
+	return fmt.Errorf("cannot decode %s into %s", v.TypeString(), rv.Type())
+}
+
+func decodeInteger(rv reflect.Value, signed bool, i int64, u uint64, sig string) error {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if !signed {
+			if u > 1<<63-1 {
+				return fmt.Errorf("%s value %d overflows %s", sig, u, rv.Type())
+			}
+			i = int64(u)
+		}
+		if rv.OverflowInt(i) {
+			return fmt.Errorf("%s value %d overflows %s", sig, i, rv.Type())
+		}
+		rv.SetInt(i)
+		return nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if signed {
+			if i < 0 {
+				return fmt.Errorf("%s value %d overflows %s", sig, i, rv.Type())
+			}
+			u = uint64(i)
+		}
+		if rv.OverflowUint(u) {
+			return fmt.Errorf("%s value %d overflows %s", sig, u, rv.Type())
+		}
+		rv.SetUint(u)
+		return nil
+	}
+
+	return fmt.Errorf("cannot decode %s into %s", sig, rv.Type())
+}
+
+func (v *Variant) decodeArray(rv reflect.Value) error {
+	n := int(v.NChildren())
+
+	switch rv.Kind() {
+	case reflect.Slice:
+		if v.TypeString() == "ay" && rv.Type().Elem().Kind() == reflect.Uint8 {
+			rv.SetBytes(v.GetBytes())
+			return nil
+		}
+
+		s := reflect.MakeSlice(rv.Type(), n, n)
+		for i := 0; i < n; i++ {
+			if err := v.GetChildValue(uint(i)).decodeInto(s.Index(i)); err != nil {
+				return err
+			}
+		}
+		rv.Set(s)
+		return nil
+
+	case reflect.Array:
+		if rv.Len() != n {
+			return fmt.Errorf("cannot decode %d elements into %s", n, rv.Type())
+		}
+		for i := 0; i < n; i++ {
+			if err := v.GetChildValue(uint(i)).decodeInto(rv.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case reflect.Map:
+		if v.TypeString()[1] != '{' {
+			break
+		}
+		m := reflect.MakeMapWithSize(rv.Type(), n)
+		for i := 0; i < n; i++ {
+			entry := v.GetChildValue(uint(i))
+			key := reflect.New(rv.Type().Key()).Elem()
+			if err := entry.GetChildValue(0).decodeInto(key); err != nil {
+				return err
+			}
+			val := reflect.New(rv.Type().Elem()).Elem()
+			if err := entry.GetChildValue(1).decodeInto(val); err != nil {
+				return err
+			}
+			m.SetMapIndex(key, val)
+		}
+		rv.Set(m)
+		return nil
+	}
+
+	return fmt.Errorf("cannot decode %s into %s", v.TypeString(), rv.Type())
+}
+
+func (v *Variant) decodeTuple(rv reflect.Value) error {
+	n := int(v.NChildren())
+
+	switch rv.Kind() {
+	case reflect.Struct:
+		fields, err := variantFields(rv.Type())
+		if err != nil {
+			return err
+		}
+		if len(fields) != n {
+			return fmt.Errorf("cannot decode %s into %s: %d fields for %d members", v.TypeString(), rv.Type(), len(fields), n)
+		}
+		for i, f := range fields {
+			if err := v.GetChildValue(uint(i)).decodeInto(rv.Field(f.index)); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case reflect.Slice, reflect.Array:
+		return v.decodeArray(rv)
+	}
+
+	return fmt.Errorf("cannot decode %s into %s", v.TypeString(), rv.Type())
+}
+
+// goValue returns the natural Go value of v, as stored by Decode in an
+// interface{}.
+func (v *Variant) goValue() interface{} {
+	switch v.Classify() {
+	case VARIANT_CLASS_BOOLEAN:
+		return v.GetBoolean()
+	case VARIANT_CLASS_BYTE:
+		u, _ := v.GetUint()
+		return uint8(u)
+	case VARIANT_CLASS_INT16:
+		i, _ := v.GetInt()
+		return int16(i)
+	case VARIANT_CLASS_UINT16:
+		u, _ := v.GetUint()
+		return uint16(u)
+	case VARIANT_CLASS_INT32:
+		i, _ := v.GetInt()
+		return int32(i)
+	case VARIANT_CLASS_UINT32:
+		u, _ := v.GetUint()
+		return uint32(u)
+	case VARIANT_CLASS_INT64:
+		i, _ := v.GetInt()
+		return i
+	case VARIANT_CLASS_UINT64:
+		u, _ := v.GetUint()
+		return u
+	case VARIANT_CLASS_HANDLE:
+		return v.GetHandle()
+	case VARIANT_CLASS_DOUBLE:
+		return v.GetDouble()
+	case VARIANT_CLASS_STRING, VARIANT_CLASS_OBJECT_PATH, VARIANT_CLASS_SIGNATURE:
+		return v.GetString()
+	case VARIANT_CLASS_VARIANT:
+		return v.GetVariant().goValue()
+
+	case VARIANT_CLASS_MAYBE:
+		if child := v.GetMaybe(); child != nil {
+			return child.goValue()
+		}
+		return nil
+
+	case VARIANT_CLASS_ARRAY:
+		sig := v.TypeString()
+		n := v.NChildren()
+		switch {
+		case sig == "ay":
+			return v.GetBytes()
+		case sig[1] == '{' && (sig[2] == 's' || sig[2] == 'o' || sig[2] == 'g'):
+			m := make(map[string]interface{}, n)
+			for i := uint(0); i < n; i++ {
+				entry := v.GetChildValue(i)
+				m[entry.GetChildValue(0).GetString()] = entry.GetChildValue(1).goValue()
+			}
+			return m
+		case sig[1] == '{':
+			m := make(map[interface{}]interface{}, n)
+			for i := uint(0); i < n; i++ {
+				entry := v.GetChildValue(i)
+				m[entry.GetChildValue(0).goValue()] = entry.GetChildValue(1).goValue()
+			}
+			return m
+		}
+		fallthrough
+
+	default:
+		// Arrays, tuples and dict entries.
+		n := v.NChildren()
+		s := make([]interface{}, n)
+		for i := range s {
+			s[i] = v.GetChildValue(uint(i)).goValue()
+		}
+		return s
+	}
+}
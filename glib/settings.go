@@ -4,8 +4,25 @@ package glib
 // #include <glib.h>
 // #include <glib-object.h>
 // #include "glib.go.h"
+// #include "settings.go.h"
 import "C"
-import "unsafe"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/internal/callback"
+)
+
+// SettingsBindFlags is a representation of GIO's GSettingsBindFlags.
+type SettingsBindFlags int
+
+const (
+	SETTINGS_BIND_DEFAULT        SettingsBindFlags = C.G_SETTINGS_BIND_DEFAULT
+	SETTINGS_BIND_GET            SettingsBindFlags = C.G_SETTINGS_BIND_GET
+	SETTINGS_BIND_SET            SettingsBindFlags = C.G_SETTINGS_BIND_SET
+	SETTINGS_BIND_NO_SENSITIVITY SettingsBindFlags = C.G_SETTINGS_BIND_NO_SENSITIVITY
+	SETTINGS_BIND_GET_NO_CHANGES SettingsBindFlags = C.G_SETTINGS_BIND_GET_NO_CHANGES
+	SETTINGS_BIND_INVERT_BOOLEAN SettingsBindFlags = C.G_SETTINGS_BIND_INVERT_BOOLEAN
+)
 
 // Settings is a representation of GSettings.
 type Settings struct {
@@ -78,10 +95,15 @@ func SettingsNewWithBackendAndPath(schemaID string, backend *SettingsBackend, pa
 	return wrapFullSettings(C.g_settings_new_with_backend_and_path(cstr1, backend.native(), cstr2))
 }
 
-// SettingsNewFull is a wrapper around g_settings_new_full().
+// SettingsNewFull is a wrapper around g_settings_new_full(). backend may be
+// nil for the default backend, and path empty for a schema with a fixed
+// path.
 func SettingsNewFull(schema *SettingsSchema, backend *SettingsBackend, path string) *Settings {
-	cstr1 := (*C.gchar)(C.CString(path))
-	defer C.free(unsafe.Pointer(cstr1))
+	var cstr1 *C.gchar
+	if path != "" {
+		cstr1 = (*C.gchar)(C.CString(path))
+		defer C.free(unsafe.Pointer(cstr1))
+	}
 
 	return wrapFullSettings(C.g_settings_new_full(schema.native(), backend.native(), cstr1))
 }
@@ -283,22 +305,171 @@ func (v *Settings) GetValue(name string) *Variant {
 	return newVariant(C.g_settings_get_value(v.native(), cstr))
 }
 
-// GVariant * 	g_settings_get_value ()
-// gboolean 	g_settings_set_value ()
-// GVariant * 	g_settings_get_user_value ()
-// GVariant * 	g_settings_get_default_value ()
+// SetValue is a wrapper around g_settings_set_value(). It returns false if
+// the key is not writable.
+func (v *Settings) SetValue(name string, value IVariant) bool {
+	cstr := (*C.gchar)(C.CString(name))
+	defer C.free(unsafe.Pointer(cstr))
+
+	return gobool(C.g_settings_set_value(v.native(), cstr, value.ToGVariant()))
+}
+
+// GetUserValue is a wrapper around g_settings_get_user_value(). It returns
+// nil if the user did not set the key, which then has its default value.
+func (v *Settings) GetUserValue(name string) *Variant {
+	cstr := (*C.gchar)(C.CString(name))
+	defer C.free(unsafe.Pointer(cstr))
+
+	return assumeVariant(C.g_settings_get_user_value(v.native(), cstr))
+}
+
+// GetDefaultValue is a wrapper around g_settings_get_default_value().
+func (v *Settings) GetDefaultValue(name string) *Variant {
+	cstr := (*C.gchar)(C.CString(name))
+	defer C.free(unsafe.Pointer(cstr))
+
+	return assumeVariant(C.g_settings_get_default_value(v.native(), cstr))
+}
+
+// GetSettingsSchema returns the value of the "settings-schema" property,
+// the schema describing the keys of the Settings.
+func (v *Settings) GetSettingsSchema() *SettingsSchema {
+	return takeSettingsSchema(C._g_settings_get_settings_schema(v.native()))
+}
+
+// GetRange returns the range of the values allowed for the key, see
+// SettingsSchemaKey.GetRange. It replaces g_settings_get_range(), which is
+// deprecated.
+func (v *Settings) GetRange(name string) *Variant {
+	return v.GetSettingsSchema().GetKey(name).GetRange()
+}
+
+// RangeCheck reports whether value is allowed for the key, see
+// SettingsSchemaKey.RangeCheck. It replaces g_settings_range_check(), which
+// is deprecated.
+func (v *Settings) RangeCheck(name string, value IVariant) bool {
+	return v.GetSettingsSchema().GetKey(name).RangeCheck(value)
+}
+
+// Bind is a wrapper around g_settings_bind(). It keeps property of object
+// and the key in sync, in the directions given by flags; the binding is
+// removed when object is finalized, or with SettingsUnbind.
+func (v *Settings) Bind(name string, object IObject, property string, flags SettingsBindFlags) {
+	cname := (*C.gchar)(C.CString(name))
+	defer C.free(unsafe.Pointer(cname))
+	cprop := (*C.gchar)(C.CString(property))
+	defer C.free(unsafe.Pointer(cprop))
+
+	C.g_settings_bind(v.native(), cname, C.gpointer(object.toObject().native()), cprop,
+		C.GSettingsBindFlags(flags))
+}
+
+// SettingsBindGetMapping converts the value of a key to the value of the
+// bound property. value is initialized to the type of the property, and is
+// set by the function, e.g. with SetString. It returns false if variant can
+// not be converted.
+type SettingsBindGetMapping func(value *Value, variant *Variant) bool
+
+// SettingsBindSetMapping converts the value of a bound property to a value
+// of expectedType for the key. It returns nil if value can not be converted.
+type SettingsBindSetMapping func(value *Value, expectedType *VariantType) *Variant
+
+// settingsBindMapping holds the mapping functions of a binding.
+type settingsBindMapping struct {
+	get SettingsBindGetMapping
+	set SettingsBindSetMapping
+}
+
+// BindWithMapping is a wrapper around g_settings_bind_with_mapping(). It is
+// like Bind, converting the values with getMapping and setMapping instead of
+// the default conversions. Either of them may be nil to use the default
+// conversion in that direction.
+func (v *Settings) BindWithMapping(name string, object IObject, property string, flags SettingsBindFlags,
+	getMapping SettingsBindGetMapping, setMapping SettingsBindSetMapping) {
+	cname := (*C.gchar)(C.CString(name))
+	defer C.free(unsafe.Pointer(cname))
+	cprop := (*C.gchar)(C.CString(property))
+	defer C.free(unsafe.Pointer(cprop))
+
+	mapping := &settingsBindMapping{getMapping, setMapping}
+	C._g_settings_bind_with_mapping(v.native(), cname, C.gpointer(object.toObject().native()), cprop,
+		C.GSettingsBindFlags(flags), gbool(getMapping != nil), gbool(setMapping != nil),
+		C.gpointer(callback.Assign(mapping)))
+}
+
+//export goSettingsBindGetMapping
+func goSettingsBindGetMapping(value *C.GValue, variant *C.GVariant, userData C.gpointer) C.gboolean {
+	mapping := callback.Get(uintptr(userData)).(*settingsBindMapping)
+	return gbool(mapping.get(&Value{value}, takeVariant(variant)))
+}
+
+//export goSettingsBindSetMapping
+func goSettingsBindSetMapping(value *C.GValue, expectedType *C.GVariantType, userData C.gpointer) *C.GVariant {
+	mapping := callback.Get(uintptr(userData)).(*settingsBindMapping)
+	variant := mapping.set(&Value{value}, newVariantType(expectedType))
+	if variant == nil {
+		return nil
+	}
+	// GIO takes the returned reference, ours is released by the finalizer.
+	return C.g_variant_ref(variant.native())
+}
+
+// BindWritable is a wrapper around g_settings_bind_writable(). It keeps
+// the boolean property of object, usually "sensitive", set to whether the
+// key is writable, or to the opposite if inverted is true.
+func (v *Settings) BindWritable(name string, object IObject, property string, inverted bool) {
+	cname := (*C.gchar)(C.CString(name))
+	defer C.free(unsafe.Pointer(cname))
+	cprop := (*C.gchar)(C.CString(property))
+	defer C.free(unsafe.Pointer(cprop))
+
+	C.g_settings_bind_writable(v.native(), cname, C.gpointer(object.toObject().native()), cprop, gbool(inverted))
+}
+
+// SettingsUnbind is a wrapper around g_settings_unbind(). It removes the
+// binding of property of object made with Bind, BindWithMapping or
+// BindWritable.
+func SettingsUnbind(object IObject, property string) {
+	cprop := (*C.gchar)(C.CString(property))
+	defer C.free(unsafe.Pointer(cprop))
+
+	C.g_settings_unbind(C.gpointer(object.toObject().native()), cprop)
+}
+
+// ConnectChanged connects f to the "changed" signal, emitted with the name
+// of a key when its value changes. If name is not empty, f is only called
+// for that key, by connecting to "changed::name".
+func (v *Settings) ConnectChanged(name string, f func(settings *Settings, name string)) SignalHandle {
+	signal := "changed"
+	if name != "" {
+		signal += "::" + name
+	}
+	return v.ConnectMarshal(signal, func(_ *Value, p []Value) {
+		key, _ := p[1].GetString()
+		f(wrapSettings(p[0].GetObject()), key)
+	})
+}
+
+// ConnectWritableChanged connects f to the "writable-changed" signal,
+// emitted with the name of a key when it becomes writable or read-only. If
+// name is not empty, f is only called for that key.
+func (v *Settings) ConnectWritableChanged(name string, f func(settings *Settings, name string)) SignalHandle {
+	signal := "writable-changed"
+	if name != "" {
+		signal += "::" + name
+	}
+	return v.ConnectMarshal(signal, func(_ *Value, p []Value) {
+		key, _ := p[1].GetString()
+		f(wrapSettings(p[0].GetObject()), key)
+	})
+}
+
 // const gchar * const * 	g_settings_list_schemas ()
 // const gchar * const * 	g_settings_list_relocatable_schemas ()
 // gchar ** 	g_settings_list_keys ()
-// GVariant * 	g_settings_get_range ()
-// gboolean 	g_settings_range_check ()
 // void 	g_settings_get ()
 // gboolean 	g_settings_set ()
 // gpointer 	g_settings_get_mapped ()
-// void 	g_settings_bind ()
-// void 	g_settings_bind_with_mapping ()
-// void 	g_settings_bind_writable ()
-// void 	g_settings_unbind ()
 // gaction * 	g_settings_create_action ()
 // gchar ** 	g_settings_get_strv ()
 // gboolean 	g_settings_set_strv ()
@@ -0,0 +1,33 @@
+// Same copyright and license as the rest of the files in this project
+
+#include <gio/gio.h>
+#include <glib.h>
+
+/*
+ * GSettingsBindGetMapping, GSettingsBindSetMapping
+ */
+
+extern gboolean goSettingsBindGetMapping(GValue *value, GVariant *variant,
+                                         gpointer user_data);
+
+extern GVariant *goSettingsBindSetMapping(GValue *value,
+                                          GVariantType *expected_type,
+                                          gpointer user_data);
+
+static inline void _g_settings_bind_with_mapping(
+    GSettings *settings, const gchar *key, gpointer object,
+    const gchar *property, GSettingsBindFlags flags, gboolean get_mapping,
+    gboolean set_mapping, gpointer user_data) {
+  g_settings_bind_with_mapping(
+      settings, key, object, property, flags,
+      get_mapping ? (GSettingsBindGetMapping)(goSettingsBindGetMapping) : NULL,
+      set_mapping ? (GSettingsBindSetMapping)(goSettingsBindSetMapping) : NULL,
+      user_data, (GDestroyNotify)(removeSourceFunc));
+}
+
+static inline GSettingsSchema *
+_g_settings_get_settings_schema(GSettings *settings) {
+  GSettingsSchema *schema = NULL;
+  g_object_get(settings, "settings-schema", &schema, NULL);
+  return schema;
+}
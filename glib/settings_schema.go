@@ -5,7 +5,10 @@ package glib
 // #include <glib-object.h>
 // #include "glib.go.h"
 import "C"
-import "unsafe"
+import (
+	"runtime"
+	"unsafe"
+)
 
 // SettingsSchema is a representation of GSettingsSchema.
 type SettingsSchema struct {
@@ -19,6 +22,16 @@ func wrapSettingsSchema(obj *C.GSettingsSchema) *SettingsSchema {
 	return &SettingsSchema{obj}
 }
 
+// takeSettingsSchema wraps a GSettingsSchema returned with full ownership
+// transfer and sets up a finalizer to release it during GC.
+func takeSettingsSchema(obj *C.GSettingsSchema) *SettingsSchema {
+	v := wrapSettingsSchema(obj)
+	if v != nil {
+		runtime.SetFinalizer(v, func(v *SettingsSchema) { FinalizerStrategy(v.Unref) })
+	}
+	return v
+}
+
 func (v *SettingsSchema) Native() uintptr {
 	return uintptr(unsafe.Pointer(v.schema))
 }
@@ -72,24 +85,103 @@ func toGoStringArray(c **C.gchar) []string {
 
 }
 
-// // ListChildren() is a wrapper around g_settings_schema_list_children().
-// func (v *SettingsSchema) ListChildren() []string {
-// 	return toGoStringArray(C.g_settings_schema_list_children(v.native()))
-// }
+// GetKey is a wrapper around g_settings_schema_get_key(). name must be a
+// key of the schema.
+func (v *SettingsSchema) GetKey(name string) *SettingsSchemaKey {
+	cstr := (*C.gchar)(C.CString(name))
+	defer C.free(unsafe.Pointer(cstr))
 
-// // ListKeys() is a wrapper around g_settings_schema_list_keys().
-// func (v *SettingsSchema) ListKeys() []string {
-// 	return toGoStringArray(C.g_settings_schema_list_keys(v.native()))
-// }
+	return takeSettingsSchemaKey(C.g_settings_schema_get_key(v.native(), cstr))
+}
 
-// const GVariantType * 	g_settings_schema_key_get_value_type ()
-// GVariant * 	g_settings_schema_key_get_default_value ()
-// GVariant * 	g_settings_schema_key_get_range ()
-// gboolean 	g_settings_schema_key_range_check ()
-// const gchar * 	g_settings_schema_key_get_name ()
-// const gchar * 	g_settings_schema_key_get_summary ()
-// const gchar * 	g_settings_schema_key_get_description ()
+/*
+ * GSettingsSchemaKey
+ */
 
-// GSettingsSchemaKey * 	g_settings_schema_get_key ()
-// GSettingsSchemaKey * 	g_settings_schema_key_ref ()
-// void 	g_settings_schema_key_unref ()
+// SettingsSchemaKey is a representation of GSettingsSchemaKey, the
+// description of a key of a SettingsSchema.
+type SettingsSchemaKey struct {
+	key *C.GSettingsSchemaKey
+}
+
+// takeSettingsSchemaKey wraps a GSettingsSchemaKey returned with full
+// ownership transfer and sets up a finalizer to release it during GC.
+func takeSettingsSchemaKey(obj *C.GSettingsSchemaKey) *SettingsSchemaKey {
+	if obj == nil {
+		return nil
+	}
+	v := &SettingsSchemaKey{obj}
+	runtime.SetFinalizer(v, func(v *SettingsSchemaKey) { FinalizerStrategy(v.Unref) })
+	return v
+}
+
+// Native returns a pointer to the underlying GSettingsSchemaKey.
+func (v *SettingsSchemaKey) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func (v *SettingsSchemaKey) native() *C.GSettingsSchemaKey {
+	if v == nil || v.key == nil {
+		return nil
+	}
+	return v.key
+}
+
+// Ref is a wrapper around g_settings_schema_key_ref().
+// Reference counting is usually handled in the gotk layer,
+// most applications should not need to call this.
+func (v *SettingsSchemaKey) Ref() {
+	C.g_settings_schema_key_ref(v.native())
+}
+
+// Unref is a wrapper around g_settings_schema_key_unref().
+// Reference counting is usually handled in the gotk layer,
+// most applications should not need to call this.
+func (v *SettingsSchemaKey) Unref() {
+	C.g_settings_schema_key_unref(v.native())
+}
+
+// GetValueType is a wrapper around g_settings_schema_key_get_value_type().
+func (v *SettingsSchemaKey) GetValueType() *VariantType {
+	c := C.g_settings_schema_key_get_value_type(v.native())
+	return takeVariantType(C.g_variant_type_copy(c))
+}
+
+// GetDefaultValue is a wrapper around
+// g_settings_schema_key_get_default_value().
+func (v *SettingsSchemaKey) GetDefaultValue() *Variant {
+	return assumeVariant(C.g_settings_schema_key_get_default_value(v.native()))
+}
+
+// GetRange is a wrapper around g_settings_schema_key_get_range(). The range
+// is a (sv) tuple, whose string tells the kind of the range and whose value
+// holds its details:
+//
+//	"type": any value of the type of the key is allowed, the value is an
+//	empty array.
+//	"enum": the value is an array of the allowed values.
+//	"flags": the value is an array of the allowed flags.
+//	"range": the value is a tuple of the minimum and the maximum.
+func (v *SettingsSchemaKey) GetRange() *Variant {
+	return assumeVariant(C.g_settings_schema_key_get_range(v.native()))
+}
+
+// RangeCheck is a wrapper around g_settings_schema_key_range_check(). value
+// must be of the type of the key.
+func (v *SettingsSchemaKey) RangeCheck(value IVariant) bool {
+	return gobool(C.g_settings_schema_key_range_check(v.native(), value.ToGVariant()))
+}
+
+// GetSummary is a wrapper around g_settings_schema_key_get_summary(). It
+// returns the translated summary of the key, or an empty string if it has
+// none.
+func (v *SettingsSchemaKey) GetSummary() string {
+	return C.GoString((*C.char)(C.g_settings_schema_key_get_summary(v.native())))
+}
+
+// GetDescription is a wrapper around
+// g_settings_schema_key_get_description(). It returns the translated
+// description of the key, or an empty string if it has none.
+func (v *SettingsSchemaKey) GetDescription() string {
+	return C.GoString((*C.char)(C.g_settings_schema_key_get_description(v.native())))
+}
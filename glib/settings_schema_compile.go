@@ -0,0 +1,68 @@
+package glib
+
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+import "C"
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"unsafe"
+)
+
+// SettingsSchemaSourceCompile compiles schemas with glib-compile-schemas,
+// which must be in the PATH, and returns a SettingsSchemaSource holding
+// them. It allows programs and tests to use their own schemas without
+// installing them system-wide. The parent of the source is the default one,
+// so that recursive lookups also find the installed schemas.
+//
+// schemas maps file names to their contents, like the files of a schema
+// directory: the schemas are in files ending with ".gschema.xml", and the
+// enums and overrides they use in ".enums.xml" and ".gschema.override" files.
+// SettingsSchemaSourceCompileFS reads them from a file system, such as an
+// embed.FS.
+//
+// The schemas are compiled in a temporary directory, removed once they are
+// loaded since the compiled file stays mapped in memory. On Windows, where
+// mapped files can not be removed, the directory is left behind.
+func SettingsSchemaSourceCompile(schemas map[string]string) (*SettingsSchemaSource, error) {
+	compiler, err := exec.LookPath("glib-compile-schemas")
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := ioutil.TempDir("", "gotk3-schemas")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	for name, data := range schemas {
+		if name != filepath.Base(name) {
+			return nil, fmt.Errorf("invalid schema file name %q", name)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+			return nil, err
+		}
+	}
+
+	out, err := exec.Command(compiler, "--strict", dir).CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("glib-compile-schemas: %v: %s", err, strings.TrimSpace(string(out)))
+	}
+
+	cstr := (*C.gchar)(C.CString(dir))
+	defer C.free(unsafe.Pointer(cstr))
+
+	var gerr *C.GError
+	c := C.g_settings_schema_source_new_from_directory(cstr, SettingsSchemaSourceGetDefault().native(), C.TRUE, &gerr)
+	if c == nil {
+		return nil, takeError(gerr)
+	}
+	return wrapSettingsSchemaSource(c), nil
+}
@@ -0,0 +1,46 @@
+//go:build go1.16
+// +build go1.16
+
+package glib
+
+import (
+	"io/fs"
+	"path"
+	"strings"
+)
+
+// SettingsSchemaSourceCompileFS is like SettingsSchemaSourceCompile, for
+// the schema, enums and override files in dir of fsys, e.g.
+//
+//	//go:embed schemas
+//	var schemas embed.FS
+//
+//	source, err := glib.SettingsSchemaSourceCompileFS(schemas, "schemas")
+func SettingsSchemaSourceCompileFS(fsys fs.FS, dir string) (*SettingsSchemaSource, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	schemas := make(map[string]string)
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !isSchemaFile(name) {
+			continue
+		}
+
+		data, err := fs.ReadFile(fsys, path.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		schemas[name] = string(data)
+	}
+	return SettingsSchemaSourceCompile(schemas)
+}
+
+// isSchemaFile reports whether name is a file used by glib-compile-schemas.
+func isSchemaFile(name string) bool {
+	return strings.HasSuffix(name, ".gschema.xml") ||
+		strings.HasSuffix(name, ".enums.xml") ||
+		strings.HasSuffix(name, ".gschema.override")
+}
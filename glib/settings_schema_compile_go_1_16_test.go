@@ -0,0 +1,31 @@
+//go:build go1.16
+// +build go1.16
+
+package glib_test
+
+import (
+	"errors"
+	"os/exec"
+	"testing"
+	"testing/fstest"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func TestSettingsSchemaSourceCompileFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"schemas/org.gotk3.test.gschema.xml": {Data: []byte(testSchema)},
+		"schemas/README":                     {Data: []byte("not a schema")},
+	}
+
+	source, err := glib.SettingsSchemaSourceCompileFS(fsys, "schemas")
+	if errors.Is(err, exec.ErrNotFound) {
+		t.Skip("glib-compile-schemas not available")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if source.Lookup("org.gotk3.test", false) == nil {
+		t.Error("compiled schema not found")
+	}
+}
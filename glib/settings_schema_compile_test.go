@@ -0,0 +1,56 @@
+package glib_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/glib/glibtest"
+)
+
+func TestSettingsSchemaSourceCompile(t *testing.T) {
+	source := glibtest.NewSchemaSource(t, map[string]string{
+		"org.gotk3.test.gschema.xml": testSchema,
+	})
+
+	schema := source.Lookup("org.gotk3.test", false)
+	if schema == nil {
+		t.Fatal("compiled schema not found")
+	}
+	if !schema.HasKey("volume") {
+		t.Error("compiled schema has no volume key")
+	}
+	if source.Lookup("org.gotk3.missing", true) != nil {
+		t.Error("found a schema that does not exist")
+	}
+
+	_, err := glib.SettingsSchemaSourceCompile(map[string]string{
+		"org.gotk3.broken.gschema.xml": `<schemalist><schema id="org.gotk3.broken">`,
+	})
+	if err == nil || !strings.Contains(err.Error(), "glib-compile-schemas") {
+		t.Errorf("expected a compilation error, got %v", err)
+	}
+
+	_, err = glib.SettingsSchemaSourceCompile(map[string]string{"../escape.gschema.xml": testSchema})
+	if err == nil {
+		t.Error("expected an error for a file name outside of the directory")
+	}
+}
+
+func TestSettingsRelocatable(t *testing.T) {
+	settings := glibtest.NewSettings(t, `<schemalist>
+  <schema id="org.gotk3.relocatable">
+    <key name="name" type="s">
+      <default>'gopher'</default>
+    </key>
+  </schema>
+</schemalist>`, "org.gotk3.relocatable")
+
+	if s := settings.GetString("name"); s != "gopher" {
+		t.Errorf("expected default gopher, got %q", s)
+	}
+	settings.SetString("name", "gnome")
+	if s := settings.GetString("name"); s != "gnome" {
+		t.Errorf("expected gnome, got %q", s)
+	}
+}
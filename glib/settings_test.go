@@ -0,0 +1,112 @@
+package glib_test
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/glib/glibtest"
+)
+
+const testSchema = `<schemalist>
+  <schema id="org.gotk3.test" path="/org/gotk3/test/">
+    <key name="enabled" type="b">
+      <default>true</default>
+    </key>
+    <key name="volume" type="i">
+      <range min="0" max="11"/>
+      <default>5</default>
+      <summary>Volume</summary>
+      <description>How loud it is.</description>
+    </key>
+  </schema>
+</schemalist>`
+
+func TestSettingsSchemaKey(t *testing.T) {
+	settings := glibtest.NewSettings(t, testSchema, "org.gotk3.test")
+
+	key := settings.GetSettingsSchema().GetKey("volume")
+	if s := key.GetValueType().String(); s != "i" {
+		t.Errorf("expected type i, got %s", s)
+	}
+	if s := key.GetSummary(); s != "Volume" {
+		t.Errorf("expected summary Volume, got %q", s)
+	}
+	if s := key.GetDescription(); s != "How loud it is." {
+		t.Errorf("unexpected description %q", s)
+	}
+	if i, _ := key.GetDefaultValue().GetInt(); i != 5 {
+		t.Errorf("expected default 5, got %d", i)
+	}
+
+	r := key.GetRange()
+	if kind := r.GetChildValue(0).GetString(); kind != "range" {
+		t.Errorf("expected a range, got %s", kind)
+	}
+	if !key.RangeCheck(glib.VariantFromInt32(11)) {
+		t.Error("11 out of range")
+	}
+	if settings.RangeCheck("volume", glib.VariantFromInt32(12)) {
+		t.Error("12 in range")
+	}
+
+	if settings.GetUserValue("volume") != nil {
+		t.Error("expected no user value")
+	}
+	settings.SetInt("volume", 7)
+	if i, _ := settings.GetUserValue("volume").GetInt(); i != 7 {
+		t.Errorf("expected user value 7, got %d", i)
+	}
+	if i, _ := settings.GetDefaultValue("volume").GetInt(); i != 5 {
+		t.Errorf("expected default value 5, got %d", i)
+	}
+}
+
+func TestSettingsBind(t *testing.T) {
+	settings := glibtest.NewSettings(t, testSchema, "org.gotk3.test")
+
+	var changed []string
+	settings.ConnectChanged("enabled", func(_ *glib.Settings, name string) {
+		changed = append(changed, name)
+	})
+
+	action := glib.SimpleActionNew("action", nil)
+	settings.Bind("enabled", action, "enabled", glib.SETTINGS_BIND_DEFAULT)
+
+	muted := glib.SimpleActionNew("muted", nil)
+	settings.BindWithMapping("volume", muted, "enabled", glib.SETTINGS_BIND_GET,
+		func(value *glib.Value, variant *glib.Variant) bool {
+			i, err := variant.GetInt()
+			if err != nil {
+				return false
+			}
+			value.SetBool(i == 0)
+			return true
+		}, nil)
+
+	settings.SetBoolean("enabled", false)
+	settings.SetInt("volume", 0)
+	for glib.MainContextDefault().Iteration(false) {
+	}
+
+	if action.GetEnabled() {
+		t.Error("bound property not updated from the key")
+	}
+	if !muted.GetEnabled() {
+		t.Error("mapped property not updated from the key")
+	}
+
+	action.SetEnabled(true)
+	if !settings.GetBoolean("enabled") {
+		t.Error("key not updated from the bound property")
+	}
+
+	if len(changed) != 2 || changed[0] != "enabled" {
+		t.Errorf("expected 2 changes of enabled, got %q", changed)
+	}
+
+	glib.SettingsUnbind(action, "enabled")
+	action.SetEnabled(false)
+	if !settings.GetBoolean("enabled") {
+		t.Error("key updated after SettingsUnbind")
+	}
+}
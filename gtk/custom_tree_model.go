@@ -0,0 +1,298 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtk
+
+// #include <gtk/gtk.h>
+// #include "gtk.go.h"
+// #include "custom_tree_model.go.h"
+import "C"
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/internal/callback"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		{glib.Type(C._gotk_custom_tree_model_get_type()), marshalCustomTreeModel},
+	}
+
+	glib.RegisterGValueMarshalers(tm)
+
+	WrapMap["GotkCustomTreeModel"] = wrapCustomTreeModel
+}
+
+/*
+ * GtkTreeModel implemented in Go
+ */
+
+// TreeModelImplementor is implemented by Go types providing the rows of a
+// CustomTreeModel. Its methods are the GtkTreeModelIface virtual methods.
+//
+// Methods filling in an iter return whether a row was found. Rows are told
+// apart by the data stored with TreeIter.SetUserData and friends; the stamp
+// of the iter is handled by CustomTreeModel.
+type TreeModelImplementor interface {
+	GetFlags() TreeModelFlags
+	GetNColumns() int
+	GetColumnType(index int) glib.Type
+	// GetIter sets iter to the row at path.
+	GetIter(iter *TreeIter, path *TreePath) bool
+	GetPath(iter *TreeIter) *TreePath
+	// GetValue returns the value of the cell, which is converted to the
+	// column type. A nil value leaves the cell empty.
+	GetValue(iter *TreeIter, column int) interface{}
+	// IterNext moves iter to the next row at the same level.
+	IterNext(iter *TreeIter) bool
+	// IterChildren sets iter to the first child of parent. parent is nil
+	// for the toplevel rows.
+	IterChildren(iter, parent *TreeIter) bool
+	IterHasChild(iter *TreeIter) bool
+	// IterNChildren returns the number of children of iter, or of the
+	// toplevel rows if iter is nil.
+	IterNChildren(iter *TreeIter) int
+	// IterNthChild sets iter to the child of parent at index n. parent is
+	// nil for the toplevel rows.
+	IterNthChild(iter, parent *TreeIter, n int) bool
+	// IterParent sets iter to the parent of child.
+	IterParent(iter, child *TreeIter) bool
+}
+
+// CustomTreeModel is a GtkTreeModel whose rows are provided by a
+// TreeModelImplementor. It can be used wherever GTK expects a model, such as
+// with TreeView, ComboBox or TreeModelFilter.
+type CustomTreeModel struct {
+	*glib.Object
+
+	// Interfaces
+	TreeModel
+}
+
+// native returns a pointer to the underlying GotkCustomTreeModel.
+func (v *CustomTreeModel) native() *C.GotkCustomTreeModel {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGotkCustomTreeModel(p)
+}
+
+func marshalCustomTreeModel(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapCustomTreeModel(obj), nil
+}
+
+func wrapCustomTreeModel(obj *glib.Object) *CustomTreeModel {
+	if obj == nil {
+		return nil
+	}
+
+	tm := wrapTreeModel(obj)
+	return &CustomTreeModel{obj, *tm}
+}
+
+// CustomTreeModelNew creates a GtkTreeModel backed by impl. impl is kept
+// alive until the model is finalized.
+func CustomTreeModelNew(impl TreeModelImplementor) (*CustomTreeModel, error) {
+	id := callback.Assign(impl)
+	c := C._gotk_custom_tree_model_new(C.guint(id))
+	if c == nil {
+		callback.Delete(id)
+		return nil, nilPtrErr
+	}
+
+	return wrapCustomTreeModel(glib.AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// InvalidateIters changes the stamp of the model, so that GTK treats all
+// iters previously handed out as invalid. It should be called when rows are
+// removed or reordered and the model does not have TREE_MODEL_ITERS_PERSIST.
+func (v *CustomTreeModel) InvalidateIters() {
+	v.native().stamp++
+}
+
+// GetUserData returns the first model specific word stored in the TreeIter.
+func (v *TreeIter) GetUserData() uintptr {
+	return uintptr(v.GtkTreeIter.user_data)
+}
+
+// SetUserData stores the first model specific word in the TreeIter. It must
+// be an integer such as a row index, not a Go pointer.
+func (v *TreeIter) SetUserData(data uintptr) {
+	v.GtkTreeIter.user_data = C.gpointer(data)
+}
+
+// GetUserData2 returns the second model specific word stored in the TreeIter.
+func (v *TreeIter) GetUserData2() uintptr {
+	return uintptr(v.GtkTreeIter.user_data2)
+}
+
+// SetUserData2 stores the second model specific word in the TreeIter, see
+// SetUserData.
+func (v *TreeIter) SetUserData2(data uintptr) {
+	v.GtkTreeIter.user_data2 = C.gpointer(data)
+}
+
+// GetUserData3 returns the third model specific word stored in the TreeIter.
+func (v *TreeIter) GetUserData3() uintptr {
+	return uintptr(v.GtkTreeIter.user_data3)
+}
+
+// SetUserData3 stores the third model specific word in the TreeIter, see
+// SetUserData.
+func (v *TreeIter) SetUserData3(data uintptr) {
+	v.GtkTreeIter.user_data3 = C.gpointer(data)
+}
+
+// RowChanged is a wrapper around gtk_tree_model_row_changed().
+func (v *TreeModel) RowChanged(path *TreePath, iter *TreeIter) {
+	C.gtk_tree_model_row_changed(v.native(), path.native(), iter.native())
+}
+
+// RowInserted is a wrapper around gtk_tree_model_row_inserted().
+func (v *TreeModel) RowInserted(path *TreePath, iter *TreeIter) {
+	C.gtk_tree_model_row_inserted(v.native(), path.native(), iter.native())
+}
+
+// RowHasChildToggled is a wrapper around
+// gtk_tree_model_row_has_child_toggled().
+func (v *TreeModel) RowHasChildToggled(path *TreePath, iter *TreeIter) {
+	C.gtk_tree_model_row_has_child_toggled(v.native(), path.native(), iter.native())
+}
+
+// RowDeleted is a wrapper around gtk_tree_model_row_deleted().
+func (v *TreeModel) RowDeleted(path *TreePath) {
+	C.gtk_tree_model_row_deleted(v.native(), path.native())
+}
+
+// RowsReordered is a wrapper around gtk_tree_model_rows_reordered_with_length().
+// newOrder[i] is the former position of the row now at position i.
+func (v *TreeModel) RowsReordered(path *TreePath, iter *TreeIter, newOrder []int) {
+	if len(newOrder) == 0 {
+		return
+	}
+
+	order := make([]C.gint, len(newOrder))
+	for i, n := range newOrder {
+		order[i] = C.gint(n)
+	}
+	C.gtk_tree_model_rows_reordered_with_length(v.native(), path.native(), iter.native(),
+		&order[0], C.gint(len(order)))
+}
+
+func customTreeModelImplementor(id C.guint) TreeModelImplementor {
+	return callback.Get(uintptr(id)).(TreeModelImplementor)
+}
+
+// customTreeModelIter runs f with a Go copy of iter and copies the result
+// back to the C iter.
+func customTreeModelIter(iter *C.GtkTreeIter, f func(*TreeIter) bool) C.gboolean {
+	goIter := &TreeIter{*iter}
+	ok := f(goIter)
+	*iter = goIter.GtkTreeIter
+	return gbool(ok)
+}
+
+// customTreeModelParent returns a Go copy of an iter passed by GTK, which may
+// be NULL.
+func customTreeModelParent(iter *C.GtkTreeIter) *TreeIter {
+	if iter == nil {
+		return nil
+	}
+	return &TreeIter{*iter}
+}
+
+//export goCustomTreeModelFinalize
+func goCustomTreeModelFinalize(id C.guint) {
+	callback.Delete(uintptr(id))
+}
+
+//export goCustomTreeModelGetFlags
+func goCustomTreeModelGetFlags(id C.guint) C.GtkTreeModelFlags {
+	return C.GtkTreeModelFlags(customTreeModelImplementor(id).GetFlags())
+}
+
+//export goCustomTreeModelGetNColumns
+func goCustomTreeModelGetNColumns(id C.guint) C.gint {
+	return C.gint(customTreeModelImplementor(id).GetNColumns())
+}
+
+//export goCustomTreeModelGetColumnType
+func goCustomTreeModelGetColumnType(id C.guint, index C.gint) C.GType {
+	return C.GType(customTreeModelImplementor(id).GetColumnType(int(index)))
+}
+
+//export goCustomTreeModelGetIter
+func goCustomTreeModelGetIter(id C.guint, iter *C.GtkTreeIter, path *C.GtkTreePath) C.gboolean {
+	return customTreeModelIter(iter, func(it *TreeIter) bool {
+		return customTreeModelImplementor(id).GetIter(it, &TreePath{path})
+	})
+}
+
+//export goCustomTreeModelGetPath
+func goCustomTreeModelGetPath(id C.guint, iter *C.GtkTreeIter) *C.GtkTreePath {
+	path := customTreeModelImplementor(id).GetPath(&TreeIter{*iter})
+	if path == nil {
+		return nil
+	}
+	// GTK takes ownership of the returned path, while the Go one is
+	// released by its finalizer.
+	return C.gtk_tree_path_copy(path.native())
+}
+
+//export goCustomTreeModelGetValue
+func goCustomTreeModelGetValue(id C.guint, iter *C.GtkTreeIter, column C.gint, value *C.GValue) {
+	v := customTreeModelImplementor(id).GetValue(&TreeIter{*iter}, int(column))
+	if v == nil {
+		return
+	}
+
+	gv, err := glib.GValue(v)
+	if err != nil {
+		panic(fmt.Sprintf("column %d: %v", column, err))
+	}
+
+	src := (*C.GValue)(unsafe.Pointer(gv.Native()))
+	if !gobool(C._gotk_custom_tree_model_set_value(value, src)) {
+		panic(fmt.Sprintf("column %d: cannot convert %T to the column type", column, v))
+	}
+}
+
+//export goCustomTreeModelIterNext
+func goCustomTreeModelIterNext(id C.guint, iter *C.GtkTreeIter) C.gboolean {
+	return customTreeModelIter(iter, customTreeModelImplementor(id).IterNext)
+}
+
+//export goCustomTreeModelIterChildren
+func goCustomTreeModelIterChildren(id C.guint, iter, parent *C.GtkTreeIter) C.gboolean {
+	return customTreeModelIter(iter, func(it *TreeIter) bool {
+		return customTreeModelImplementor(id).IterChildren(it, customTreeModelParent(parent))
+	})
+}
+
+//export goCustomTreeModelIterHasChild
+func goCustomTreeModelIterHasChild(id C.guint, iter *C.GtkTreeIter) C.gboolean {
+	return gbool(customTreeModelImplementor(id).IterHasChild(&TreeIter{*iter}))
+}
+
+//export goCustomTreeModelIterNChildren
+func goCustomTreeModelIterNChildren(id C.guint, iter *C.GtkTreeIter) C.gint {
+	return C.gint(customTreeModelImplementor(id).IterNChildren(customTreeModelParent(iter)))
+}
+
+//export goCustomTreeModelIterNthChild
+func goCustomTreeModelIterNthChild(id C.guint, iter, parent *C.GtkTreeIter, n C.gint) C.gboolean {
+	return customTreeModelIter(iter, func(it *TreeIter) bool {
+		return customTreeModelImplementor(id).IterNthChild(it, customTreeModelParent(parent), int(n))
+	})
+}
+
+//export goCustomTreeModelIterParent
+func goCustomTreeModelIterParent(id C.guint, iter, child *C.GtkTreeIter) C.gboolean {
+	return customTreeModelIter(iter, func(it *TreeIter) bool {
+		return customTreeModelImplementor(id).IterParent(it, &TreeIter{*child})
+	})
+}
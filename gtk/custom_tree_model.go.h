@@ -0,0 +1,213 @@
+// Same copyright and license as the rest of the files in this project
+
+#ifndef __CUSTOM_TREE_MODEL_GO_H__
+#define __CUSTOM_TREE_MODEL_GO_H__
+
+#include <gtk/gtk.h>
+#include <stdlib.h>
+
+/*
+ * GotkCustomTreeModel is a GObject implementing GtkTreeModel by forwarding
+ * every method to a Go TreeModelImplementor, found with the callback id
+ * stored in the instance.
+ */
+typedef struct {
+  GObject parent;
+  guint id;
+  gint stamp;
+} GotkCustomTreeModel;
+
+typedef struct {
+  GObjectClass parent_class;
+} GotkCustomTreeModelClass;
+
+extern void goCustomTreeModelFinalize(guint id);
+extern GtkTreeModelFlags goCustomTreeModelGetFlags(guint id);
+extern gint goCustomTreeModelGetNColumns(guint id);
+extern GType goCustomTreeModelGetColumnType(guint id, gint index);
+extern gboolean goCustomTreeModelGetIter(guint id, GtkTreeIter *iter,
+                                         GtkTreePath *path);
+extern GtkTreePath *goCustomTreeModelGetPath(guint id, GtkTreeIter *iter);
+extern void goCustomTreeModelGetValue(guint id, GtkTreeIter *iter,
+                                      gint column, GValue *value);
+extern gboolean goCustomTreeModelIterNext(guint id, GtkTreeIter *iter);
+extern gboolean goCustomTreeModelIterChildren(guint id, GtkTreeIter *iter,
+                                              GtkTreeIter *parent);
+extern gboolean goCustomTreeModelIterHasChild(guint id, GtkTreeIter *iter);
+extern gint goCustomTreeModelIterNChildren(guint id, GtkTreeIter *iter);
+extern gboolean goCustomTreeModelIterNthChild(guint id, GtkTreeIter *iter,
+                                              GtkTreeIter *parent, gint n);
+extern gboolean goCustomTreeModelIterParent(guint id, GtkTreeIter *iter,
+                                            GtkTreeIter *child);
+
+static GotkCustomTreeModel *toGotkCustomTreeModel(void *p) {
+  return ((GotkCustomTreeModel *)p);
+}
+
+/*
+ * Iterators handed out by the model carry its current stamp, so that GTK can
+ * detect iterators which outlived a change of the model.
+ */
+static gboolean _gotk_custom_tree_model_stamp(GtkTreeModel *model,
+                                              GtkTreeIter *iter,
+                                              gboolean valid) {
+  iter->stamp = valid ? ((GotkCustomTreeModel *)model)->stamp : 0;
+  return valid;
+}
+
+static guint _gotk_custom_tree_model_id(GtkTreeModel *model) {
+  return ((GotkCustomTreeModel *)model)->id;
+}
+
+static GtkTreeModelFlags
+_gotk_custom_tree_model_get_flags(GtkTreeModel *model) {
+  return goCustomTreeModelGetFlags(_gotk_custom_tree_model_id(model));
+}
+
+static gint _gotk_custom_tree_model_get_n_columns(GtkTreeModel *model) {
+  return goCustomTreeModelGetNColumns(_gotk_custom_tree_model_id(model));
+}
+
+static GType _gotk_custom_tree_model_get_column_type(GtkTreeModel *model,
+                                                     gint index) {
+  return goCustomTreeModelGetColumnType(_gotk_custom_tree_model_id(model),
+                                        index);
+}
+
+static gboolean _gotk_custom_tree_model_get_iter(GtkTreeModel *model,
+                                                 GtkTreeIter *iter,
+                                                 GtkTreePath *path) {
+  return _gotk_custom_tree_model_stamp(
+      model, iter,
+      goCustomTreeModelGetIter(_gotk_custom_tree_model_id(model), iter, path));
+}
+
+static GtkTreePath *_gotk_custom_tree_model_get_path(GtkTreeModel *model,
+                                                     GtkTreeIter *iter) {
+  return goCustomTreeModelGetPath(_gotk_custom_tree_model_id(model), iter);
+}
+
+static void _gotk_custom_tree_model_get_value(GtkTreeModel *model,
+                                              GtkTreeIter *iter, gint column,
+                                              GValue *value) {
+  g_value_init(value, goCustomTreeModelGetColumnType(
+                          _gotk_custom_tree_model_id(model), column));
+  goCustomTreeModelGetValue(_gotk_custom_tree_model_id(model), iter, column,
+                            value);
+}
+
+static gboolean _gotk_custom_tree_model_iter_next(GtkTreeModel *model,
+                                                  GtkTreeIter *iter) {
+  return _gotk_custom_tree_model_stamp(
+      model, iter,
+      goCustomTreeModelIterNext(_gotk_custom_tree_model_id(model), iter));
+}
+
+static gboolean _gotk_custom_tree_model_iter_children(GtkTreeModel *model,
+                                                      GtkTreeIter *iter,
+                                                      GtkTreeIter *parent) {
+  return _gotk_custom_tree_model_stamp(
+      model, iter,
+      goCustomTreeModelIterChildren(_gotk_custom_tree_model_id(model), iter,
+                                    parent));
+}
+
+static gboolean _gotk_custom_tree_model_iter_has_child(GtkTreeModel *model,
+                                                       GtkTreeIter *iter) {
+  return goCustomTreeModelIterHasChild(_gotk_custom_tree_model_id(model), iter);
+}
+
+static gint _gotk_custom_tree_model_iter_n_children(GtkTreeModel *model,
+                                                    GtkTreeIter *iter) {
+  return goCustomTreeModelIterNChildren(_gotk_custom_tree_model_id(model),
+                                        iter);
+}
+
+static gboolean _gotk_custom_tree_model_iter_nth_child(GtkTreeModel *model,
+                                                       GtkTreeIter *iter,
+                                                       GtkTreeIter *parent,
+                                                       gint n) {
+  return _gotk_custom_tree_model_stamp(
+      model, iter,
+      goCustomTreeModelIterNthChild(_gotk_custom_tree_model_id(model), iter,
+                                    parent, n));
+}
+
+static gboolean _gotk_custom_tree_model_iter_parent(GtkTreeModel *model,
+                                                    GtkTreeIter *iter,
+                                                    GtkTreeIter *child) {
+  return _gotk_custom_tree_model_stamp(
+      model, iter,
+      goCustomTreeModelIterParent(_gotk_custom_tree_model_id(model), iter,
+                                  child));
+}
+
+/*
+ * Stores a value returned by Go in the cell value, converting it to the
+ * column type if needed.
+ */
+static gboolean _gotk_custom_tree_model_set_value(GValue *value,
+                                                  const GValue *src) {
+  if (g_value_type_compatible(G_VALUE_TYPE(src), G_VALUE_TYPE(value))) {
+    g_value_copy(src, value);
+    return TRUE;
+  }
+  return g_value_transform(src, value);
+}
+
+static void _gotk_custom_tree_model_init_iface(GtkTreeModelIface *iface) {
+  iface->get_flags = _gotk_custom_tree_model_get_flags;
+  iface->get_n_columns = _gotk_custom_tree_model_get_n_columns;
+  iface->get_column_type = _gotk_custom_tree_model_get_column_type;
+  iface->get_iter = _gotk_custom_tree_model_get_iter;
+  iface->get_path = _gotk_custom_tree_model_get_path;
+  iface->get_value = _gotk_custom_tree_model_get_value;
+  iface->iter_next = _gotk_custom_tree_model_iter_next;
+  iface->iter_children = _gotk_custom_tree_model_iter_children;
+  iface->iter_has_child = _gotk_custom_tree_model_iter_has_child;
+  iface->iter_n_children = _gotk_custom_tree_model_iter_n_children;
+  iface->iter_nth_child = _gotk_custom_tree_model_iter_nth_child;
+  iface->iter_parent = _gotk_custom_tree_model_iter_parent;
+}
+
+static GObjectClass *_gotk_custom_tree_model_parent_class = NULL;
+
+static void _gotk_custom_tree_model_finalize(GObject *object) {
+  goCustomTreeModelFinalize(((GotkCustomTreeModel *)object)->id);
+  _gotk_custom_tree_model_parent_class->finalize(object);
+}
+
+static void _gotk_custom_tree_model_class_init(gpointer g_class,
+                                               gpointer class_data) {
+  _gotk_custom_tree_model_parent_class = g_type_class_peek_parent(g_class);
+  G_OBJECT_CLASS(g_class)->finalize = _gotk_custom_tree_model_finalize;
+}
+
+static GType _gotk_custom_tree_model_get_type() {
+  static gsize type_id = 0;
+
+  if (g_once_init_enter(&type_id)) {
+    GType t = g_type_register_static_simple(
+        G_TYPE_OBJECT, "GotkCustomTreeModel",
+        sizeof(GotkCustomTreeModelClass), _gotk_custom_tree_model_class_init,
+        sizeof(GotkCustomTreeModel), NULL, 0);
+
+    GInterfaceInfo iface_info = {
+        (GInterfaceInitFunc)_gotk_custom_tree_model_init_iface, NULL, NULL};
+    g_type_add_interface_static(t, GTK_TYPE_TREE_MODEL, &iface_info);
+
+    g_once_init_leave(&type_id, t);
+  }
+
+  return type_id;
+}
+
+static GotkCustomTreeModel *_gotk_custom_tree_model_new(guint id) {
+  GotkCustomTreeModel *model =
+      g_object_new(_gotk_custom_tree_model_get_type(), NULL);
+  model->id = id;
+  model->stamp = g_random_int();
+  return model;
+}
+
+#endif
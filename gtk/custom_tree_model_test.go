@@ -0,0 +1,148 @@
+package gtk
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+// stringListModel is a flat TreeModelImplementor with one string column,
+// identifying rows by index + 1 so that the zero iter is never a valid row.
+type stringListModel struct {
+	rows []string
+}
+
+func (m *stringListModel) GetFlags() TreeModelFlags {
+	return TREE_MODEL_LIST_ONLY
+}
+
+func (m *stringListModel) GetNColumns() int {
+	return 1
+}
+
+func (m *stringListModel) GetColumnType(index int) glib.Type {
+	return glib.TYPE_STRING
+}
+
+func (m *stringListModel) row(iter *TreeIter) int {
+	return int(iter.GetUserData()) - 1
+}
+
+func (m *stringListModel) setRow(iter *TreeIter, n int) bool {
+	if n < 0 || n >= len(m.rows) {
+		return false
+	}
+	iter.SetUserData(uintptr(n + 1))
+	return true
+}
+
+func (m *stringListModel) GetIter(iter *TreeIter, path *TreePath) bool {
+	indices := path.GetIndices()
+	if len(indices) != 1 {
+		return false
+	}
+	return m.setRow(iter, indices[0])
+}
+
+func (m *stringListModel) GetPath(iter *TreeIter) *TreePath {
+	path, _ := TreePathNewFirst()
+	for i := 0; i < m.row(iter); i++ {
+		path.Next()
+	}
+	return path
+}
+
+func (m *stringListModel) GetValue(iter *TreeIter, column int) interface{} {
+	return m.rows[m.row(iter)]
+}
+
+func (m *stringListModel) IterNext(iter *TreeIter) bool {
+	return m.setRow(iter, m.row(iter)+1)
+}
+
+func (m *stringListModel) IterChildren(iter, parent *TreeIter) bool {
+	if parent != nil {
+		return false
+	}
+	return m.setRow(iter, 0)
+}
+
+func (m *stringListModel) IterHasChild(iter *TreeIter) bool {
+	return false
+}
+
+func (m *stringListModel) IterNChildren(iter *TreeIter) int {
+	if iter != nil {
+		return 0
+	}
+	return len(m.rows)
+}
+
+func (m *stringListModel) IterNthChild(iter, parent *TreeIter, n int) bool {
+	if parent != nil {
+		return false
+	}
+	return m.setRow(iter, n)
+}
+
+func (m *stringListModel) IterParent(iter, child *TreeIter) bool {
+	return false
+}
+
+func TestCustomTreeModel(t *testing.T) {
+	impl := &stringListModel{rows: []string{"a", "b", "c"}}
+	model, err := CustomTreeModelNew(impl)
+	if err != nil {
+		t.Fatal("CustomTreeModelNew failed:", err)
+	}
+
+	if n := model.IterNChildren(nil); n != 3 {
+		t.Errorf("Expected 3 rows, got %d", n)
+	}
+	if typ := model.GetColumnType(0); typ != glib.TYPE_STRING {
+		t.Errorf("Expected string column, got %s", typ.Name())
+	}
+
+	var got []string
+	iter, ok := model.GetIterFirst()
+	for ok {
+		val, err := model.GetValue(iter, 0)
+		if err != nil {
+			t.Fatal("GetValue failed:", err)
+		}
+		s, _ := val.GetString()
+		got = append(got, s)
+		ok = model.IterNext(iter)
+	}
+	if len(got) != 3 || got[0] != "a" || got[2] != "c" {
+		t.Errorf("Expected [a b c], got %v", got)
+	}
+
+	iter, err = model.GetIterFromString("1")
+	if err != nil {
+		t.Fatal("GetIterFromString failed:", err)
+	}
+	if s := model.GetStringFromIter(iter); s != "1" {
+		t.Errorf("Expected path 1, got %s", s)
+	}
+
+	var changed bool
+	model.Connect("row-changed", func() {
+		changed = true
+	})
+	path, _ := model.GetPath(iter)
+	impl.rows[1] = "B"
+	model.RowChanged(path, iter)
+	if !changed {
+		t.Error("Expected row-changed to be emitted")
+	}
+
+	// The model must be usable through GTK's own models.
+	filter, err := model.FilterNew(nil)
+	if err != nil {
+		t.Fatal("FilterNew failed:", err)
+	}
+	if n := filter.IterNChildren(nil); n != 3 {
+		t.Errorf("Expected 3 filtered rows, got %d", n)
+	}
+}
@@ -1598,6 +1598,49 @@ func (v *Bin) GetChild() (IWidget, error) {
 	return castWidget(c)
 }
 
+/*
+ * GtkBuilderError
+ */
+
+// BuilderErrorQuark is a wrapper around gtk_builder_error_quark().
+func BuilderErrorQuark() glib.Quark {
+	return glib.Quark(C.gtk_builder_error_quark())
+}
+
+// BuilderError is a representation of GTK's GtkBuilderError, the codes of
+// the errors of the BuilderErrorQuark() domain.
+type BuilderError int
+
+const (
+	BUILDER_ERROR_INVALID_TYPE_FUNCTION  BuilderError = C.GTK_BUILDER_ERROR_INVALID_TYPE_FUNCTION
+	BUILDER_ERROR_UNHANDLED_TAG          BuilderError = C.GTK_BUILDER_ERROR_UNHANDLED_TAG
+	BUILDER_ERROR_MISSING_ATTRIBUTE      BuilderError = C.GTK_BUILDER_ERROR_MISSING_ATTRIBUTE
+	BUILDER_ERROR_INVALID_ATTRIBUTE      BuilderError = C.GTK_BUILDER_ERROR_INVALID_ATTRIBUTE
+	BUILDER_ERROR_INVALID_TAG            BuilderError = C.GTK_BUILDER_ERROR_INVALID_TAG
+	BUILDER_ERROR_MISSING_PROPERTY_VALUE BuilderError = C.GTK_BUILDER_ERROR_MISSING_PROPERTY_VALUE
+	BUILDER_ERROR_INVALID_VALUE          BuilderError = C.GTK_BUILDER_ERROR_INVALID_VALUE
+	BUILDER_ERROR_VERSION_MISMATCH       BuilderError = C.GTK_BUILDER_ERROR_VERSION_MISMATCH
+	BUILDER_ERROR_DUPLICATE_ID           BuilderError = C.GTK_BUILDER_ERROR_DUPLICATE_ID
+)
+
+// Sentinel errors of the BuilderErrorQuark() domain, to be used with
+// errors.Is.
+var (
+	BuilderErrorInvalidTypeFunction  = builderError(BUILDER_ERROR_INVALID_TYPE_FUNCTION, "invalid type function")
+	BuilderErrorUnhandledTag         = builderError(BUILDER_ERROR_UNHANDLED_TAG, "unhandled tag")
+	BuilderErrorMissingAttribute     = builderError(BUILDER_ERROR_MISSING_ATTRIBUTE, "missing attribute")
+	BuilderErrorInvalidAttribute     = builderError(BUILDER_ERROR_INVALID_ATTRIBUTE, "invalid attribute")
+	BuilderErrorInvalidTag           = builderError(BUILDER_ERROR_INVALID_TAG, "invalid tag")
+	BuilderErrorMissingPropertyValue = builderError(BUILDER_ERROR_MISSING_PROPERTY_VALUE, "missing property value")
+	BuilderErrorInvalidValue         = builderError(BUILDER_ERROR_INVALID_VALUE, "invalid value")
+	BuilderErrorVersionMismatch      = builderError(BUILDER_ERROR_VERSION_MISMATCH, "version mismatch")
+	BuilderErrorDuplicateID          = builderError(BUILDER_ERROR_DUPLICATE_ID, "duplicate id")
+)
+
+func builderError(code BuilderError, message string) *glib.Error {
+	return glib.ErrorNew(BuilderErrorQuark(), int(code), message)
+}
+
 /*
  * GtkBuilder
  */
@@ -1639,8 +1682,7 @@ func (b *Builder) AddFromFile(filename string) error {
 	var err *C.GError = nil
 	res := C.gtk_builder_add_from_file(b.native(), (*C.gchar)(cstr), &err)
 	if res == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
@@ -1652,8 +1694,7 @@ func (b *Builder) AddFromResource(path string) error {
 	var err *C.GError = nil
 	res := C.gtk_builder_add_from_resource(b.native(), (*C.gchar)(cstr), &err)
 	if res == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
@@ -1666,8 +1707,7 @@ func (b *Builder) AddFromString(str string) error {
 	var err *C.GError = nil
 	res := C.gtk_builder_add_from_string(b.native(), (*C.gchar)(cstr), length, &err)
 	if res == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
@@ -3443,8 +3483,7 @@ func (v *CssProvider) LoadFromPath(path string) error {
 	defer C.free(unsafe.Pointer(cpath))
 	var gerr *C.GError
 	if C.gtk_css_provider_load_from_path(v.native(), (*C.gchar)(cpath), &gerr) == 0 {
-		defer C.g_error_free(gerr)
-		return errors.New(goString(gerr.message))
+		return glib.TakeError(unsafe.Pointer(gerr))
 	}
 	return nil
 }
@@ -3455,8 +3494,7 @@ func (v *CssProvider) LoadFromData(data string) error {
 	defer C.free(unsafe.Pointer(cdata))
 	var gerr *C.GError
 	if C.gtk_css_provider_load_from_data(v.native(), (*C.gchar)(unsafe.Pointer(cdata)), C.gssize(len(data)), &gerr) == 0 {
-		defer C.g_error_free(gerr)
-		return errors.New(goString(gerr.message))
+		return glib.TakeError(unsafe.Pointer(gerr))
 	}
 	return nil
 }
@@ -5632,8 +5670,7 @@ func (v *IconTheme) LoadIcon(iconName string, size int, flags IconLookupFlags) (
 	var err *C.GError = nil
 	c := C.gtk_icon_theme_load_icon(v.Theme, (*C.gchar)(cstr), C.gint(size), C.GtkIconLookupFlags(flags), &err)
 	if c == nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 	return &gdk.Pixbuf{glib.Take(unsafe.Pointer(c))}, nil
 }
@@ -9770,8 +9807,7 @@ func (v *TextBuffer) Deserialize(contentBuffer *TextBuffer, format gdk.Atom, ite
 	cbool := C.gtk_text_buffer_deserialize(v.native(), contentBuffer.native(), C.GdkAtom(unsafe.Pointer(format)),
 		(*C.GtkTextIter)(iter), (*C.guint8)(unsafe.Pointer(&data[0])), length, &cerr)
 	if !gobool(cbool) {
-		defer C.g_error_free(cerr)
-		return false, errors.New(goString(cerr.message))
+		return false, glib.TakeError(unsafe.Pointer(cerr))
 	}
 	return gobool(cbool), nil
 }
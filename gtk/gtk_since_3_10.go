@@ -11,7 +11,6 @@ package gtk
 // #include "gtk_since_3_10.go.h"
 import "C"
 import (
-	"errors"
 	"unsafe"
 
 	"github.com/gotk3/gotk3/cairo"
@@ -139,8 +138,7 @@ func (v *IconTheme) LoadIconForScale(iconName string, size, scale int, flags Ico
 	var err *C.GError = nil
 	c := C.gtk_icon_theme_load_icon_for_scale(v.Theme, (*C.gchar)(cstr), C.gint(size), C.gint(scale), C.GtkIconLookupFlags(flags), &err)
 	if c == nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 	return &gdk.Pixbuf{glib.Take(unsafe.Pointer(c))}, nil
 }
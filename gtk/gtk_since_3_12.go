@@ -50,6 +50,22 @@ const (
 	BUTTONBOX_EXPAND ButtonBoxStyle = C.GTK_BUTTONBOX_EXPAND
 )
 
+const (
+	BUILDER_ERROR_TEMPLATE_MISMATCH BuilderError = C.GTK_BUILDER_ERROR_TEMPLATE_MISMATCH
+	BUILDER_ERROR_INVALID_PROPERTY  BuilderError = C.GTK_BUILDER_ERROR_INVALID_PROPERTY
+	BUILDER_ERROR_INVALID_SIGNAL    BuilderError = C.GTK_BUILDER_ERROR_INVALID_SIGNAL
+	BUILDER_ERROR_INVALID_ID        BuilderError = C.GTK_BUILDER_ERROR_INVALID_ID
+)
+
+// Sentinel errors of the BuilderErrorQuark() domain added in GTK 3.10 and
+// 3.12, to be used with errors.Is.
+var (
+	BuilderErrorTemplateMismatch = builderError(BUILDER_ERROR_TEMPLATE_MISMATCH, "template mismatch")
+	BuilderErrorInvalidProperty  = builderError(BUILDER_ERROR_INVALID_PROPERTY, "invalid property")
+	BuilderErrorInvalidSignal    = builderError(BUILDER_ERROR_INVALID_SIGNAL, "invalid signal")
+	BuilderErrorInvalidID        = builderError(BUILDER_ERROR_INVALID_ID, "invalid id")
+)
+
 func init() {
 	tm := []glib.TypeMarshaler{
 		// Objects/Interfaces
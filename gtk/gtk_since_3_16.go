@@ -113,7 +113,10 @@ func (v *Notebook) DetachTab(child IWidget) {
  */
 
 // ListBoxCreateWidgetFunc is a representation of GtkListBoxCreateWidgetFunc.
-type ListBoxCreateWidgetFunc func(item interface{}) int
+// It returns the widget representing item. item is the Go value of the
+// model item: for a glib.ListItem, as found in a glib.SliceListModel, this is
+// the value it holds.
+type ListBoxCreateWidgetFunc func(item interface{}) IWidget
 
 /*
  * GtkScrolledWindow
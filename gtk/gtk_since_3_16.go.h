@@ -37,7 +37,8 @@ static GtkStackSidebar *toGtkStackSidebar(void *p) {
 
 static GtkGLArea *toGtkGLArea(void *p) { return (GTK_GL_AREA(p)); }
 
-extern void goListBoxCreateWidgetFuncs(gpointer item, gpointer user_data);
+extern GtkWidget *goListBoxCreateWidgetFuncs(gpointer item,
+                                             gpointer user_data);
 
 static inline void _gtk_list_box_bind_model(GtkListBox *box, GListModel *model,
                                             gpointer user_data) {
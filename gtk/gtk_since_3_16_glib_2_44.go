@@ -6,13 +6,14 @@
 package gtk
 
 // #include <gtk/gtk.h>
+// #include "gtk.go.h"
 // #include "gtk_since_3_16.go.h"
 import "C"
 import (
 	"unsafe"
 
-	"github.com/gotk3/gotk3/internal/callback"
 	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/internal/callback"
 )
 
 // BindModel is a wrapper around gtk_list_box_bind_model().
@@ -23,3 +24,37 @@ func (v *ListBox) BindModel(listModel *glib.ListModel, createWidgetFunc ListBoxC
 		C.gpointer(callback.Assign(createWidgetFunc)),
 	)
 }
+
+// bindModelItem returns the Go value of an item of a GListModel passed to a
+// create widget function.
+func bindModelItem(item C.gpointer) interface{} {
+	obj := glib.Take(unsafe.Pointer(item))
+	v, err := obj.GoValue()
+	if err != nil {
+		return obj
+	}
+	if li, ok := v.(*glib.ListItem); ok {
+		return li.Value()
+	}
+	return v
+}
+
+// bindModelWidget returns the widget created for an item of a GListModel.
+func bindModelWidget(w IWidget) *C.GtkWidget {
+	if w == nil {
+		return nil
+	}
+	// GTK expects a new reference, while the Go one is released by the
+	// finalizer of w.
+	c := w.toWidget()
+	if c != nil {
+		C.g_object_ref(C.gpointer(c))
+	}
+	return c
+}
+
+//export goListBoxCreateWidgetFuncs
+func goListBoxCreateWidgetFuncs(item, userData C.gpointer) *C.GtkWidget {
+	fn := callback.Get(uintptr(userData)).(ListBoxCreateWidgetFunc)
+	return bindModelWidget(fn(bindModelItem(item)))
+}
@@ -0,0 +1,37 @@
+// Same copyright and license as the rest of the files in this project
+// The code in this file is only for GTK+ version 3.18+, as well as Glib version 2.44+
+
+// +build !gtk_3_6,!gtk_3_8,!gtk_3_10,!gtk_3_12,!gtk_3_14,!gtk_3_16,!glib_2_40,!glib_2_42
+
+package gtk
+
+// #include <gtk/gtk.h>
+// #include "gtk.go.h"
+// #include "gtk_since_3_16.go.h"
+// #include "gtk_since_3_18_glib_2_44.go.h"
+import "C"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/internal/callback"
+)
+
+// FlowBoxCreateWidgetFunc is a representation of GtkFlowBoxCreateWidgetFunc.
+// item is passed as for ListBoxCreateWidgetFunc.
+type FlowBoxCreateWidgetFunc func(item interface{}) IWidget
+
+// BindModel is a wrapper around gtk_flow_box_bind_model().
+func (fb *FlowBox) BindModel(listModel *glib.ListModel, createWidgetFunc FlowBoxCreateWidgetFunc) {
+	C._gtk_flow_box_bind_model(
+		fb.native(),
+		C.toGListModel(unsafe.Pointer(listModel.Native())),
+		C.gpointer(callback.Assign(createWidgetFunc)),
+	)
+}
+
+//export goFlowBoxCreateWidgetFuncs
+func goFlowBoxCreateWidgetFuncs(item, userData C.gpointer) *C.GtkWidget {
+	fn := callback.Get(uintptr(userData)).(FlowBoxCreateWidgetFunc)
+	return bindModelWidget(fn(bindModelItem(item)))
+}
@@ -0,0 +1,16 @@
+// Same copyright and license as the rest of the files in this project
+
+#pragma once
+
+#include <gtk/gtk.h>
+#include <stdlib.h>
+
+extern GtkWidget *goFlowBoxCreateWidgetFuncs(gpointer item,
+                                             gpointer user_data);
+
+static inline void _gtk_flow_box_bind_model(GtkFlowBox *box, GListModel *model,
+                                            gpointer user_data) {
+  gtk_flow_box_bind_model(
+      box, model, (GtkFlowBoxCreateWidgetFunc)(goFlowBoxCreateWidgetFuncs),
+      user_data, (GDestroyNotify)(gotk3_callbackDelete));
+}
@@ -34,3 +34,11 @@ const (
 	STATE_FLAG_DIR_LTR StateFlags = C.GTK_STATE_FLAG_DIR_LTR
 	STATE_FLAG_DIR_RTL StateFlags = C.GTK_STATE_FLAG_DIR_RTL
 )
+
+const (
+	BUILDER_ERROR_OBJECT_TYPE_REFUSED BuilderError = C.GTK_BUILDER_ERROR_OBJECT_TYPE_REFUSED
+)
+
+// BuilderErrorObjectTypeRefused is the sentinel error of the
+// BUILDER_ERROR_OBJECT_TYPE_REFUSED code, to be used with errors.Is.
+var BuilderErrorObjectTypeRefused = builderError(BUILDER_ERROR_OBJECT_TYPE_REFUSED, "object type refused")
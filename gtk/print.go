@@ -380,8 +380,7 @@ func PageSetupNewFromFile(fileName string) (*PageSetup, error) {
 	var err *C.GError = nil
 	c := C.gtk_page_setup_new_from_file((*C.gchar)(cstr), &err)
 	if c == nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(C.GoString((*C.char)(err.message)))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 	obj := glib.Take(unsafe.Pointer(c))
 	return &PageSetup{obj}, nil
@@ -397,8 +396,7 @@ func (ps *PageSetup) PageSetupLoadFile(name string) error {
 	var err *C.GError = nil
 	res := C.gtk_page_setup_load_file(ps.native(), cstr, &err)
 	if !gobool(res) {
-		defer C.g_error_free(err)
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
@@ -412,8 +410,7 @@ func (ps *PageSetup) PageSetupToFile(name string) error {
 	var err *C.GError = nil
 	res := C.gtk_page_setup_to_file(ps.native(), cstr, &err)
 	if !gobool(res) {
-		defer C.g_error_free(err)
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
@@ -793,8 +790,7 @@ func (po *PrintOperation) PrintOperationSetAllowAsync(allowSync bool) {
 func (po *PrintOperation) PrintOperationGetError() error {
 	var err *C.GError = nil
 	C.gtk_print_operation_get_error(po.native(), &err)
-	defer C.g_error_free(err)
-	return errors.New(C.GoString((*C.char)(err.message)))
+	return glib.TakeError(unsafe.Pointer(err))
 }
 
 // SetDefaultPageSetup() is a wrapper around gtk_print_operation_set_default_page_setup().
@@ -896,8 +892,7 @@ func (po *PrintOperation) Run(action PrintOperationAction, parent IWindow) (Prin
 	c := C.gtk_print_operation_run(po.native(), C.GtkPrintOperationAction(action), w, &err)
 	res := PrintOperationResult(c)
 	if res == PRINT_OPERATION_RESULT_ERROR {
-		defer C.g_error_free(err)
-		return res, errors.New(C.GoString((*C.char)(err.message)))
+		return res, glib.TakeError(unsafe.Pointer(err))
 	}
 	return res, nil
 }
@@ -1559,8 +1554,7 @@ func PrintSettingsNewFromFile(name string) (*PrintSettings, error) {
 	var err *C.GError = nil
 	c := C.gtk_print_settings_new_from_file((*C.gchar)(cstr), &err)
 	if c == nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(C.GoString((*C.char)(err.message)))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 	obj := glib.Take(unsafe.Pointer(c))
 	return wrapPrintSettings(obj), nil
@@ -1575,8 +1569,7 @@ func (ps *PrintSettings) LoadFile(name string) error {
 	var err *C.GError = nil
 	c := C.gtk_print_settings_load_file(ps.native(), (*C.gchar)(cstr), &err)
 	if gobool(c) == false {
-		defer C.g_error_free(err)
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
@@ -1590,7 +1583,7 @@ func (ps *PrintSettings) ToFile(name string) error {
 	var err *C.GError = nil
 	c := C.gtk_print_settings_to_file(ps.native(), (*C.gchar)(cstr), &err)
 	if gobool(c) == false {
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
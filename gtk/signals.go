@@ -0,0 +1,121 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtk
+
+import (
+	"github.com/gotk3/gotk3/cairo"
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/glib"
+)
+
+/*
+ * Typed signal connections
+ *
+ * The functions below connect handlers to frequently used signals. Unlike
+ * Connect, the handler's signature is checked at compile time and it is
+ * called without reflection, see glib.ClosureMarshal.
+ */
+
+// ConnectDestroy connects f to the "destroy" signal of the Widget.
+func (v *Widget) ConnectDestroy(f func(*Widget)) glib.SignalHandle {
+	return v.ConnectMarshal("destroy", func(_ *glib.Value, p []glib.Value) {
+		f(wrapWidget(p[0].GetObject()))
+	})
+}
+
+// ConnectShow connects f to the "show" signal of the Widget.
+func (v *Widget) ConnectShow(f func(*Widget)) glib.SignalHandle {
+	return v.ConnectMarshal("show", func(_ *glib.Value, p []glib.Value) {
+		f(wrapWidget(p[0].GetObject()))
+	})
+}
+
+// ConnectHide connects f to the "hide" signal of the Widget.
+func (v *Widget) ConnectHide(f func(*Widget)) glib.SignalHandle {
+	return v.ConnectMarshal("hide", func(_ *glib.Value, p []glib.Value) {
+		f(wrapWidget(p[0].GetObject()))
+	})
+}
+
+// ConnectDraw connects f to the "draw" signal of the Widget. Returning true
+// stops other handlers from being invoked.
+func (v *Widget) ConnectDraw(f func(*Widget, *cairo.Context) bool) glib.SignalHandle {
+	return v.ConnectMarshal("draw", func(ret *glib.Value, p []glib.Value) {
+		cr, _ := p[1].GoValue()
+		ret.SetBool(f(wrapWidget(p[0].GetObject()), cr.(*cairo.Context)))
+	})
+}
+
+// ConnectDeleteEvent connects f to the "delete-event" signal of the Window.
+// Returning true stops the Window from being destroyed.
+func (v *Window) ConnectDeleteEvent(f func(*Window, *gdk.Event) bool) glib.SignalHandle {
+	return v.ConnectMarshal("delete-event", func(ret *glib.Value, p []glib.Value) {
+		ev, _ := p[1].GoValue()
+		ret.SetBool(f(wrapWindow(p[0].GetObject()), ev.(*gdk.Event)))
+	})
+}
+
+// ConnectClicked connects f to the "clicked" signal of the Button.
+func (v *Button) ConnectClicked(f func(*Button)) glib.SignalHandle {
+	return v.ConnectMarshal("clicked", func(_ *glib.Value, p []glib.Value) {
+		f(wrapButton(p[0].GetObject()))
+	})
+}
+
+// ConnectToggled connects f to the "toggled" signal of the ToggleButton.
+func (v *ToggleButton) ConnectToggled(f func(*ToggleButton)) glib.SignalHandle {
+	return v.ConnectMarshal("toggled", func(_ *glib.Value, p []glib.Value) {
+		f(wrapToggleButton(p[0].GetObject()))
+	})
+}
+
+// ConnectActivate connects f to the "activate" signal of the Entry.
+func (v *Entry) ConnectActivate(f func(*Entry)) glib.SignalHandle {
+	return v.ConnectMarshal("activate", func(_ *glib.Value, p []glib.Value) {
+		f(wrapEntry(p[0].GetObject()))
+	})
+}
+
+// ConnectChanged connects f to the "changed" signal of the Entry.
+func (v *Entry) ConnectChanged(f func(*Entry)) glib.SignalHandle {
+	return v.ConnectMarshal("changed", func(_ *glib.Value, p []glib.Value) {
+		f(wrapEntry(p[0].GetObject()))
+	})
+}
+
+// ConnectValueChanged connects f to the "value-changed" signal of the Range.
+func (v *Range) ConnectValueChanged(f func(*Range)) glib.SignalHandle {
+	return v.ConnectMarshal("value-changed", func(_ *glib.Value, p []glib.Value) {
+		f(wrapRange(p[0].GetObject()))
+	})
+}
+
+// ConnectValueChanged connects f to the "value-changed" signal of the
+// Adjustment.
+func (v *Adjustment) ConnectValueChanged(f func(*Adjustment)) glib.SignalHandle {
+	return v.ConnectMarshal("value-changed", func(_ *glib.Value, p []glib.Value) {
+		f(wrapAdjustment(p[0].GetObject()))
+	})
+}
+
+// ConnectValueChanged connects f to the "value-changed" signal of the
+// SpinButton.
+func (v *SpinButton) ConnectValueChanged(f func(*SpinButton)) glib.SignalHandle {
+	return v.ConnectMarshal("value-changed", func(_ *glib.Value, p []glib.Value) {
+		f(wrapSpinButton(p[0].GetObject()))
+	})
+}
+
+// ConnectChanged connects f to the "changed" signal of the ComboBox.
+func (v *ComboBox) ConnectChanged(f func(*ComboBox)) glib.SignalHandle {
+	return v.ConnectMarshal("changed", func(_ *glib.Value, p []glib.Value) {
+		f(wrapComboBox(p[0].GetObject()))
+	})
+}
+
+// ConnectChanged connects f to the "changed" signal of the TreeSelection.
+func (v *TreeSelection) ConnectChanged(f func(*TreeSelection)) glib.SignalHandle {
+	return v.ConnectMarshal("changed", func(_ *glib.Value, p []glib.Value) {
+		f(wrapTreeSelection(p[0].GetObject()))
+	})
+}
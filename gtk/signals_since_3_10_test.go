@@ -0,0 +1,46 @@
+// +build !gtk_3_6,!gtk_3_8
+
+package gtk
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/gotk3/gotk3/gdk"
+)
+
+// TestConnectDeleteEvent tests a helper with an event argument, whose return
+// value stops the default handler.
+func TestConnectDeleteEvent(t *testing.T) {
+	runtime.LockOSThread()
+
+	win, err := WindowNew(WINDOW_TOPLEVEL)
+	if err != nil {
+		t.Fatal("WindowNew failed:", err)
+	}
+	defer win.Destroy()
+	win.Realize()
+
+	var destroyed bool
+	win.ConnectDestroy(func(*Widget) { destroyed = true })
+
+	var window *Window
+	var event *gdk.Event
+	win.ConnectDeleteEvent(func(w *Window, ev *gdk.Event) bool {
+		window, event = w, ev
+		MainQuit()
+		return true
+	})
+	win.Close()
+	runMainUntilQuit(t)
+
+	if window == nil || window.Widget.Native() != win.Widget.Native() {
+		t.Errorf("Expected the closed window, got %v", window)
+	}
+	if event == nil || event.Native() == 0 {
+		t.Error("Expected a delete event")
+	}
+	if destroyed {
+		t.Error("Expected returning true to keep the window")
+	}
+}
@@ -0,0 +1,156 @@
+package gtk
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/gotk3/gotk3/cairo"
+	"github.com/gotk3/gotk3/glib"
+)
+
+// runMainUntilQuit runs the main loop until a handler calls MainQuit, and
+// fails the test if no handler does within a few seconds.
+func runMainUntilQuit(t *testing.T) {
+	timeout := glib.TimeoutAdd(5000, func() bool {
+		t.Error("Timed out waiting for the signal")
+		MainQuit()
+		return false
+	})
+	Main()
+	glib.SourceRemove(timeout)
+}
+
+// TestConnectWidgetSignals tests the typed helpers of signals without
+// arguments other than the emitting instance.
+func TestConnectWidgetSignals(t *testing.T) {
+	var emitted []string
+	record := func(name string, got, want uintptr) {
+		if got != want {
+			t.Errorf("%s: expected the emitting instance %#x, got %#x", name, want, got)
+		}
+		emitted = append(emitted, name)
+	}
+
+	button, err := ButtonNew()
+	if err != nil {
+		t.Fatal("ButtonNew failed:", err)
+	}
+	button.ConnectClicked(func(b *Button) { record("clicked", b.Widget.Native(), button.Widget.Native()) })
+	button.ConnectShow(func(w *Widget) { record("show", w.Native(), button.Widget.Native()) })
+	button.ConnectHide(func(w *Widget) { record("hide", w.Native(), button.Widget.Native()) })
+	button.ConnectDestroy(func(w *Widget) { record("destroy", w.Native(), button.Widget.Native()) })
+	button.Clicked()
+	button.Show()
+	button.Hide()
+	button.Destroy()
+
+	toggle, err := ToggleButtonNew()
+	if err != nil {
+		t.Fatal("ToggleButtonNew failed:", err)
+	}
+	toggle.ConnectToggled(func(b *ToggleButton) { record("toggled", b.Widget.Native(), toggle.Widget.Native()) })
+	toggle.SetActive(true)
+
+	entry, err := EntryNew()
+	if err != nil {
+		t.Fatal("EntryNew failed:", err)
+	}
+	entry.ConnectChanged(func(e *Entry) { record("entry changed", e.Widget.Native(), entry.Widget.Native()) })
+	entry.ConnectActivate(func(e *Entry) { record("activate", e.Widget.Native(), entry.Widget.Native()) })
+	entry.SetText("text")
+	entry.Activate()
+
+	adjustment, err := AdjustmentNew(0, 0, 10, 1, 1, 0)
+	if err != nil {
+		t.Fatal("AdjustmentNew failed:", err)
+	}
+	adjustment.ConnectValueChanged(func(a *Adjustment) { record("adjustment value-changed", a.Native(), adjustment.Native()) })
+	adjustment.SetValue(1)
+
+	scale, err := ScaleNew(ORIENTATION_HORIZONTAL, nil)
+	if err != nil {
+		t.Fatal("ScaleNew failed:", err)
+	}
+	scale.SetRange(0, 10)
+	scale.ConnectValueChanged(func(r *Range) { record("range value-changed", r.Widget.Native(), scale.Widget.Native()) })
+	scale.SetValue(2)
+
+	spin, err := SpinButtonNew(nil, 1, 0)
+	if err != nil {
+		t.Fatal("SpinButtonNew failed:", err)
+	}
+	spin.SetRange(0, 10)
+	spin.ConnectValueChanged(func(s *SpinButton) { record("spin value-changed", s.Widget.Native(), spin.Widget.Native()) })
+	spin.SetValue(3)
+
+	combo, err := ComboBoxTextNew()
+	if err != nil {
+		t.Fatal("ComboBoxTextNew failed:", err)
+	}
+	combo.AppendText("item")
+	combo.ConnectChanged(func(c *ComboBox) { record("combo changed", c.Widget.Native(), combo.Widget.Native()) })
+	combo.SetActive(0)
+
+	store, err := ListStoreNew(glib.TYPE_STRING)
+	if err != nil {
+		t.Fatal("ListStoreNew failed:", err)
+	}
+	iter := store.Append()
+	tree, err := TreeViewNewWithModel(store)
+	if err != nil {
+		t.Fatal("TreeViewNewWithModel failed:", err)
+	}
+	selection, err := tree.GetSelection()
+	if err != nil {
+		t.Fatal("GetSelection failed:", err)
+	}
+	selection.ConnectChanged(func(s *TreeSelection) { record("selection changed", s.Native(), selection.Native()) })
+	selection.SelectIter(iter)
+
+	want := []string{"clicked", "show", "hide", "destroy", "toggled", "entry changed", "activate",
+		"adjustment value-changed", "range value-changed", "spin value-changed", "combo changed",
+		"selection changed"}
+	if len(emitted) != len(want) {
+		t.Fatalf("Expected signals %q, got %q", want, emitted)
+	}
+	for i := range want {
+		if emitted[i] != want[i] {
+			t.Errorf("Expected signals %q, got %q", want, emitted)
+			break
+		}
+	}
+}
+
+// TestConnectDraw tests a helper with an argument and a return value.
+func TestConnectDraw(t *testing.T) {
+	runtime.LockOSThread()
+
+	win, err := OffscreenWindowNew()
+	if err != nil {
+		t.Fatal("OffscreenWindowNew failed:", err)
+	}
+	defer win.Destroy()
+	area, err := DrawingAreaNew()
+	if err != nil {
+		t.Fatal("DrawingAreaNew failed:", err)
+	}
+	area.SetSizeRequest(16, 16)
+	win.Add(area)
+
+	var widget *Widget
+	var cr *cairo.Context
+	area.ConnectDraw(func(w *Widget, c *cairo.Context) bool {
+		widget, cr = w, c
+		MainQuit()
+		return true
+	})
+	win.ShowAll()
+	runMainUntilQuit(t)
+
+	if widget == nil || widget.Native() != area.Native() {
+		t.Errorf("Expected the drawing area, got %v", widget)
+	}
+	if cr == nil || cr.Native() == 0 {
+		t.Error("Expected a cairo context")
+	}
+}
@@ -6,7 +6,6 @@ package gtk
 // #include "gtk.go.h"
 import "C"
 import (
-	"errors"
 	"runtime"
 	"unsafe"
 
@@ -514,8 +513,7 @@ func WindowSetDefaultIconFromFile(file string) error {
 	var err *C.GError = nil
 	res := C.gtk_window_set_default_icon_from_file((*C.gchar)(cstr), &err)
 	if res == 0 {
-		defer C.g_error_free(err)
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
@@ -534,8 +532,7 @@ func (v *Window) SetIconFromFile(file string) error {
 	var err *C.GError = nil
 	res := C.gtk_window_set_icon_from_file(v.native(), (*C.gchar)(cstr), &err)
 	if res == 0 {
-		defer C.g_error_free(err)
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }